@@ -0,0 +1,185 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import "math"
+
+// An AffineTransform2 represents an affine transformation in 2-dimensional
+// euclidean space as a 2x3 matrix. Like for Matrix4, the indices are
+// [column][row] for transformations of column vectors, i.e. m[2] holds the
+// translation. The elements are laid out like the corresponding elements
+// of a Matrix4.
+type AffineTransform2[T Float] [3][2]T
+
+// An Affine2 is a 2-dimensional affine transformation with float32 elements.
+type Affine2 = AffineTransform2[float32]
+
+// An Affine2d is a 2-dimensional affine transformation with float64
+// elements.
+type Affine2d = AffineTransform2[float64]
+
+// ID sets m to the identity transformation and returns m.
+func (m *AffineTransform2[T]) ID() *AffineTransform2[T] {
+	*m = AffineTransform2[T]{
+		{1, 0},
+		{0, 1},
+		{0, 0},
+	}
+	return m
+}
+
+// Det calculates the determinant of the linear part of m.
+func (m *AffineTransform2[T]) Det() T {
+	return m[0][0]*m[1][1] - m[1][0]*m[0][1]
+}
+
+// Mul sets m to the composition a*b (b applied first, then a) and returns m.
+func (m *AffineTransform2[T]) Mul(a *AffineTransform2[T], b *AffineTransform2[T]) *AffineTransform2[T] {
+	*m = AffineTransform2[T]{
+		{
+			a[0][0]*b[0][0] + a[1][0]*b[0][1],
+			a[0][1]*b[0][0] + a[1][1]*b[0][1],
+		},
+		{
+			a[0][0]*b[1][0] + a[1][0]*b[1][1],
+			a[0][1]*b[1][0] + a[1][1]*b[1][1],
+		},
+		{
+			a[0][0]*b[2][0] + a[1][0]*b[2][1] + a[2][0],
+			a[0][1]*b[2][0] + a[1][1]*b[2][1] + a[2][1],
+		},
+	}
+	return m
+}
+
+// Inv sets m to the inverse transformation of a and returns m.
+// If a is not invertible the elements of m will be infinite or NaN;
+// use TryInv to detect this case.
+func (m *AffineTransform2[T]) Inv(a *AffineTransform2[T]) *AffineTransform2[T] {
+	d := 1 / a.Det()
+	b00, b01 := a[1][1]*d, -a[0][1]*d
+	b10, b11 := -a[1][0]*d, a[0][0]*d
+	t := a[2]
+	*m = AffineTransform2[T]{
+		{b00, b01},
+		{b10, b11},
+		{-(b00*t[0] + b10*t[1]), -(b01*t[0] + b11*t[1])},
+	}
+	return m
+}
+
+// TryInv sets m to the inverse transformation of a and reports whether a
+// was invertible. If a is singular, m is left unchanged and TryInv returns
+// false.
+func (m *AffineTransform2[T]) TryInv(a *AffineTransform2[T]) bool {
+	d := 1 / a.Det()
+	if math.IsInf(float64(d), 0) || math.IsNaN(float64(d)) {
+		return false
+	}
+	m.Inv(a)
+	return true
+}
+
+// Translate sets m to the translation of transformation a by the vector v
+// and returns m.
+func (m *AffineTransform2[T]) Translate(a *AffineTransform2[T], v Vector2[T]) *AffineTransform2[T] {
+	*m = AffineTransform2[T]{
+		a[0],
+		a[1],
+		{
+			a[0][0]*v.X + a[1][0]*v.Y + a[2][0],
+			a[0][1]*v.X + a[1][1]*v.Y + a[2][1],
+		},
+	}
+	return m
+}
+
+// Rot sets m to the counterclockwise rotation of transformation a by the
+// given angle in radians, and returns m.
+func (m *AffineTransform2[T]) Rot(a *AffineTransform2[T], angle T) *AffineTransform2[T] {
+	s, c := math.Sincos(float64(angle))
+	b := AffineTransform2[T]{
+		{T(c), T(s)},
+		{T(-s), T(c)},
+		{0, 0},
+	}
+	return m.Mul(a, &b)
+}
+
+// Scale sets m to the scaling of transformation a by the scale factors of v
+// and returns m.
+func (m *AffineTransform2[T]) Scale(a *AffineTransform2[T], v Vector2[T]) *AffineTransform2[T] {
+	*m = AffineTransform2[T]{
+		{a[0][0] * v.X, a[0][1] * v.X},
+		{a[1][0] * v.Y, a[1][1] * v.Y},
+		a[2],
+	}
+	return m
+}
+
+// Skew sets m to the skewing of transformation a by the angles x and y in
+// radians, and returns m. The angle x shears along the x axis
+// (x' = x + tan(x)*y), the angle y shears along the y axis
+// (y' = y + tan(y)*x).
+func (m *AffineTransform2[T]) Skew(a *AffineTransform2[T], x, y T) *AffineTransform2[T] {
+	b := AffineTransform2[T]{
+		{1, T(math.Tan(float64(y)))},
+		{T(math.Tan(float64(x))), 1},
+		{0, 0},
+	}
+	return m.Mul(a, &b)
+}
+
+// MulVec2 returns the vector v transformed by m.
+func (m *AffineTransform2[T]) MulVec2(v Vector2[T]) Vector2[T] {
+	return Vector2[T]{
+		m[0][0]*v.X + m[1][0]*v.Y + m[2][0],
+		m[0][1]*v.X + m[1][1]*v.Y + m[2][1],
+	}
+}
+
+// MulRect returns the smallest rectangle that contains all four corners
+// of rectangle r transformed by m. As a Rectangle has float32 coordinates,
+// the corners are converted to float32 after the transformation.
+func (m *AffineTransform2[T]) MulRect(r Rectangle) Rectangle {
+	corner := func(x, y float32) Vec2 {
+		return m.MulVec2(Vector2[T]{T(x), T(y)}).Float32()
+	}
+	p0 := corner(r.Min.X, r.Min.Y)
+	p1 := corner(r.Max.X, r.Min.Y)
+	p2 := corner(r.Max.X, r.Max.Y)
+	p3 := corner(r.Min.X, r.Max.Y)
+	return Rectangle{
+		Min: p0.Min(p1).Min(p2).Min(p3),
+		Max: p0.Max(p1).Max(p2).Max(p3),
+	}
+}
+
+// FromMat4 sets m to the 2-dimensional affine part of matrix a, i.e. the
+// elements affecting the x and y coordinates of vectors with z=0, and
+// returns m. This is lossless if a was created with FromAffine2 or only
+// with 2-dimensional transformations.
+func (m *AffineTransform2[T]) FromMat4(a *Matrix4[T]) *AffineTransform2[T] {
+	*m = AffineTransform2[T]{
+		{a[0][0], a[0][1]},
+		{a[1][0], a[1][1]},
+		{a[3][0], a[3][1]},
+	}
+	return m
+}
+
+// nearEq returns whether m and m2 are approximately equal. This relation is
+// not transitive in general. The tolerance for the floating-point components
+// is ±1e-5.
+func (m *AffineTransform2[T]) nearEq(m2 *AffineTransform2[T]) bool {
+	for i := range 3 {
+		for j := range 2 {
+			if !nearEq(m[i][j], m2[i][j], epsilon) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,241 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+// idAffine2 is the identity transformation.
+var idAffine2 = Affine2{
+	{1, 0},
+	{0, 1},
+	{0, 0},
+}
+
+func TestAffine2ID(t *testing.T) {
+	m := Affine2{{1, 2}, {3, 4}, {5, 6}}
+	want := Affine2{{1, 0}, {0, 1}, {0, 0}}
+	mp := m.ID()
+	if m != want {
+		t.Errorf("m.ID() does not set m to the identity transformation, got instead: %v", m)
+	}
+	if mp != &m {
+		t.Errorf("m.ID() does not return the pointer to m")
+	}
+}
+
+func TestAffine2Translate(t *testing.T) {
+	tests := []struct {
+		a    Affine2
+		v    Vec2
+		want Affine2
+	}{
+		{idAffine2, V2(2, -3), Affine2{{1, 0}, {0, 1}, {2, -3}}},
+		{Affine2{{2, 0}, {0, 3}, {1, 1}}, V2(2, -3), Affine2{{2, 0}, {0, 3}, {5, -8}}},
+	}
+	for _, tt := range tests {
+		var m Affine2
+		mp := m.Translate(&tt.a, tt.v)
+		if !tt.want.nearEq(&m) {
+			t.Errorf("m.Translate(%v, %s) = %v, want %v", tt.a, tt.v, m, tt.want)
+		}
+		if mp != &m {
+			t.Errorf("m.Translate(...) does not return the pointer to m")
+		}
+	}
+}
+
+func TestAffine2Rot(t *testing.T) {
+	tests := []struct {
+		a    Affine2
+		rad  float32
+		want Affine2
+	}{
+		{idAffine2, math.Pi / 2, Affine2{{0, 1}, {-1, 0}, {0, 0}}},
+		{Affine2{{1, 0}, {0, 1}, {1, 2}}, math.Pi / 4, Affine2{
+			{0.70710677, 0.70710677},
+			{-0.70710677, 0.70710677},
+			{1, 2},
+		}},
+	}
+	for _, tt := range tests {
+		var m Affine2
+		mp := m.Rot(&tt.a, tt.rad)
+		if !tt.want.nearEq(&m) {
+			t.Errorf("m.Rot(%v, %g) = %v, want %v", tt.a, tt.rad, m, tt.want)
+		}
+		if mp != &m {
+			t.Errorf("m.Rot(...) does not return the pointer to m")
+		}
+	}
+}
+
+func TestAffine2Scale(t *testing.T) {
+	tests := []struct {
+		a    Affine2
+		v    Vec2
+		want Affine2
+	}{
+		{idAffine2, V2(2, 3), Affine2{{2, 0}, {0, 3}, {0, 0}}},
+		{Affine2{{1, 2}, {3, 4}, {5, 6}}, V2(2, -1), Affine2{{2, 4}, {-3, -4}, {5, 6}}},
+	}
+	for _, tt := range tests {
+		var m Affine2
+		mp := m.Scale(&tt.a, tt.v)
+		if !tt.want.nearEq(&m) {
+			t.Errorf("m.Scale(%v, %s) = %v, want %v", tt.a, tt.v, m, tt.want)
+		}
+		if mp != &m {
+			t.Errorf("m.Scale(...) does not return the pointer to m")
+		}
+	}
+}
+
+func TestAffine2Skew(t *testing.T) {
+	tests := []struct {
+		x, y float32
+		v    Vec2
+		want Vec2
+	}{
+		{0, 0, V2(2, 3), V2(2, 3)},
+		{math.Pi / 4, 0, V2(2, 3), V2(5, 3)},
+		{0, math.Pi / 4, V2(2, 3), V2(2, 5)},
+	}
+	for _, tt := range tests {
+		var m Affine2
+		m.ID().Skew(&m, tt.x, tt.y)
+		if x := m.MulVec2(tt.v); !x.NearEq(tt.want) {
+			t.Errorf("skew(%g, %g) of %s = %s, want %s", tt.x, tt.y, tt.v, x, tt.want)
+		}
+	}
+}
+
+func TestAffine2Mul(t *testing.T) {
+	var rot, trans, want Affine2
+	rot.ID().Rot(&rot, math.Pi/2)
+	trans.ID().Translate(&trans, V2(2, 3))
+	// Rotate, then translate
+	want.ID().Translate(&want, V2(2, 3)).Rot(&want, math.Pi/2)
+
+	var m Affine2
+	mp := m.Mul(&trans, &rot)
+	if !want.nearEq(&m) {
+		t.Errorf("%v * %v = %v, want %v", trans, rot, m, want)
+	}
+	if mp != &m {
+		t.Errorf("m.Mul(...) does not return the pointer to m")
+	}
+	v := V2(1, 0)
+	if x, w := m.MulVec2(v), V2(2, 4); !x.NearEq(w) {
+		t.Errorf("%v.MulVec2(%s) = %s, want %s", m, v, x, w)
+	}
+}
+
+func TestAffine2MulVec2(t *testing.T) {
+	var rot, trans, scale Affine2
+	rot.ID().Rot(&rot, math.Pi/2)
+	trans.ID().Translate(&trans, V2(2.5, 3))
+	scale.ID().Scale(&scale, V2(2, 3))
+
+	tests := []struct {
+		m    *Affine2
+		v    Vec2
+		want Vec2
+	}{
+		{&rot, V2(1, 0), V2(0, 1)},
+		{&trans, V2(1, 2), V2(3.5, 5)},
+		{&scale, V2(1.5, -3), V2(3, -9)},
+	}
+	for _, tt := range tests {
+		if x := tt.m.MulVec2(tt.v); !x.NearEq(tt.want) {
+			t.Errorf("%v.MulVec2(%s) = %s, want %s", *tt.m, tt.v, x, tt.want)
+		}
+	}
+}
+
+func TestAffine2MulRect(t *testing.T) {
+	var rot, trans, scale Affine2
+	rot.ID().Rot(&rot, math.Pi/4)
+	trans.ID().Translate(&trans, V2(2.5, 3))
+	scale.ID().Scale(&scale, V2(2, -3))
+
+	tests := []struct {
+		m    *Affine2
+		r    Rectangle
+		want Rectangle
+	}{
+		{&idAffine2, Rect(1, 2, 3, 4), Rect(1, 2, 3, 4)},
+		{&trans, Rect(1, 2, 3, 4), Rect(3.5, 5, 5.5, 7)},
+		{&scale, Rect(1, 2, 3, 4), Rect(2, -12, 6, -6)},
+		{&rot, Rect(-1, -1, 1, 1), Rect(-1.4142135, -1.4142135, 1.4142135, 1.4142135)},
+	}
+	for _, tt := range tests {
+		if x := tt.m.MulRect(tt.r); !rectangleNearEq(x, tt.want) {
+			t.Errorf("%v.MulRect(%v) = %v, want %v", *tt.m, tt.r, x, tt.want)
+		}
+	}
+}
+
+func TestAffine2Inv(t *testing.T) {
+	var a Affine2
+	a.ID().Translate(&a, V2(3, -2)).Rot(&a, 0.7).Scale(&a, V2(2, 0.5)).Skew(&a, 0.3, 0)
+
+	var m, p Affine2
+	mp := m.Inv(&a)
+	if p.Mul(&a, &m); !p.nearEq(&idAffine2) {
+		t.Errorf("%v * %v = %v, want identity", a, m, p)
+	}
+	if mp != &m {
+		t.Errorf("m.Inv(...) does not return the pointer to m")
+	}
+
+	singular := Affine2{{1, 2}, {2, 4}, {5, 6}}
+	n := idAffine2
+	if n.TryInv(&singular) {
+		t.Errorf("m.TryInv(%v) = true, want false", singular)
+	}
+	if n != idAffine2 {
+		t.Errorf("m.TryInv(%v) changed m to %v for singular transformation", singular, n)
+	}
+	if !n.TryInv(&a) || n != m {
+		t.Errorf("m.TryInv(%v) = false, %v, want true, %v", a, n, m)
+	}
+}
+
+func TestAffine2Mat4(t *testing.T) {
+	var a Affine2
+	a.ID().Translate(&a, V2(3, -2)).Rot(&a, 0.7).Scale(&a, V2(2, 0.5))
+
+	var m Mat4
+	mp := m.FromAffine2(&a)
+	if mp != &m {
+		t.Errorf("m.FromAffine2(...) does not return the pointer to m")
+	}
+	var want Mat4
+	want.ID().Translate(&want, V3(3, -2, 0)).Rot(&want, 0.7, V3UnitZ).Scale(&want, V3(2, 0.5, 1))
+	if !want.NearEq(&m) {
+		t.Errorf("m.FromAffine2(%v) = %v, want %v", a, m, want)
+	}
+
+	v := V2(1.5, -4)
+	if x, w := v.Transform(&m), a.MulVec2(v); !x.NearEq(w) {
+		t.Errorf("%s.Transform(%v) = %s, want %s", v, m, x, w)
+	}
+
+	var b Affine2
+	if b.FromMat4(&m); b != a {
+		t.Errorf("m.FromMat4(%v) = %v, want %v", m, b, a)
+	}
+
+	// The float64 variant keeps its precision.
+	var ad Affine2d
+	ad.ID().Translate(&ad, V2d(1e8, 0)).Translate(&ad, V2d(1e-3, 0))
+	var md Mat4d
+	if md.FromAffine2(&ad); md[3][0] != 1e8+1e-3 {
+		t.Errorf("m.FromAffine2(%v) = %v, want translation %g", ad, md, 1e8+1e-3)
+	}
+}
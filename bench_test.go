@@ -33,6 +33,34 @@ func BenchmarkMat4Det(b *testing.B) {
 	}
 }
 
+func BenchmarkMat4Adj(b *testing.B) {
+	var m Mat4
+	for range b.N {
+		m.Adj(a)
+	}
+}
+
+func BenchmarkMat4Inv(b *testing.B) {
+	var m Mat4
+	for range b.N {
+		m.Inv(a)
+	}
+}
+
+func BenchmarkMat4InvAffine(b *testing.B) {
+	var m Mat4
+	for range b.N {
+		m.InvAffine(a)
+	}
+}
+
+func BenchmarkMat4InvOrthonormal(b *testing.B) {
+	var m Mat4
+	for range b.N {
+		m.InvOrthonormal(a)
+	}
+}
+
 func BenchmarkMat4Mul(b *testing.B) {
 	var m Mat4
 	for range b.N {
@@ -40,6 +68,14 @@ func BenchmarkMat4Mul(b *testing.B) {
 	}
 }
 
+func BenchmarkMat4dMul(b *testing.B) {
+	ad := a.Float64()
+	var m Mat4d
+	for range b.N {
+		m.Mul(&ad, &ad)
+	}
+}
+
 func BenchmarkMat4Ortho(b *testing.B) {
 	var m Mat4
 	for range b.N {
@@ -79,6 +115,14 @@ func BenchmarkMat4Rot(b *testing.B) {
 	}
 }
 
+func BenchmarkMat4RotQuat(b *testing.B) {
+	var m Mat4
+	q := QuatRot(0.5, V3(1, 2, 3))
+	for range b.N {
+		m.RotQuat(a, q)
+	}
+}
+
 func BenchmarkMat4T(b *testing.B) {
 	var m Mat4
 	for range b.N {
@@ -110,6 +154,70 @@ func BenchmarkMat4Floats(b *testing.B) {
 	_ = r
 }
 
+var a3 = &Mat3{
+	{1, 2, 4},
+	{5, 6, 7},
+	{1, 2, 3},
+}
+
+func BenchmarkMat3ID(b *testing.B) {
+	var m Mat3
+	for range b.N {
+		m.ID()
+	}
+}
+
+func BenchmarkMat3Det(b *testing.B) {
+	for range b.N {
+		a3.Det()
+	}
+}
+
+func BenchmarkMat3Mul(b *testing.B) {
+	var m Mat3
+	for range b.N {
+		m.Mul(a3, a3)
+	}
+}
+
+func BenchmarkMat3MulVec3(b *testing.B) {
+	var r Vec3
+	v := V3(1, 2, 3)
+	for range b.N {
+		r = a3.MulVec3(v)
+	}
+	_ = r
+}
+
+func BenchmarkMat3T(b *testing.B) {
+	var m Mat3
+	for range b.N {
+		m.T(a3)
+	}
+}
+
+func BenchmarkMat3Inv(b *testing.B) {
+	var m Mat3
+	for range b.N {
+		m.Inv(a3)
+	}
+}
+
+func BenchmarkMat3NormalMatrix(b *testing.B) {
+	var m Mat3
+	for range b.N {
+		m.NormalMatrix(a)
+	}
+}
+
+func BenchmarkMat3Floats(b *testing.B) {
+	var r *[9]float32
+	for range b.N {
+		r = a3.Floats()
+	}
+	_ = r
+}
+
 func BenchmarkVec2Add(b *testing.B) {
 	var r Vec2
 	v := V2(1, 2)
@@ -303,6 +411,16 @@ func BenchmarkVec3Add(b *testing.B) {
 	_ = r
 }
 
+func BenchmarkVec3dAdd(b *testing.B) {
+	var r Vec3d
+	v := V3d(1, 2, 3)
+	w := V3d(4, 5, 6)
+	for range b.N {
+		r = v.Add(w)
+	}
+	_ = r
+}
+
 func BenchmarkVec3Sub(b *testing.B) {
 	var r Vec3
 	v := V3(1, 2, 3)
@@ -475,3 +593,358 @@ func BenchmarkVec3Transform(b *testing.B) {
 	}
 	_ = r
 }
+
+func BenchmarkQuatMul(b *testing.B) {
+	var r Quat
+	p := QuatRot(0.5, V3(1, 2, 3))
+	q := QuatRot(1.5, V3(4, 5, 6))
+	for range b.N {
+		r = p.Mul(q)
+	}
+	_ = r
+}
+
+func BenchmarkQuatRotate(b *testing.B) {
+	var r Vec3
+	q := QuatRot(0.5, V3(1, 2, 3))
+	v := V3(4, 5, 6)
+	for range b.N {
+		r = q.Rotate(v)
+	}
+	_ = r
+}
+
+func BenchmarkQuatdRotate(b *testing.B) {
+	var r Vec3d
+	q := QuatRot(0.5, V3d(1, 2, 3))
+	v := V3d(4, 5, 6)
+	for range b.N {
+		r = q.Rotate(v)
+	}
+	_ = r
+}
+
+func BenchmarkQuatSlerp(b *testing.B) {
+	var r Quat
+	p := QuatRot(0.5, V3(1, 2, 3))
+	q := QuatRot(1.5, V3(4, 5, 6))
+	for range b.N {
+		r = p.Slerp(q, 0.3)
+	}
+	_ = r
+}
+
+func BenchmarkQuatNlerp(b *testing.B) {
+	var r Quat
+	p := QuatRot(0.5, V3(1, 2, 3))
+	q := QuatRot(1.5, V3(4, 5, 6))
+	for range b.N {
+		r = p.Nlerp(q, 0.3)
+	}
+	_ = r
+}
+
+var aff = &Affine2{
+	{1, 2},
+	{3, 4},
+	{5, 6},
+}
+
+func BenchmarkAffine2Mul(b *testing.B) {
+	var m Affine2
+	for range b.N {
+		m.Mul(aff, aff)
+	}
+}
+
+func BenchmarkAffine2Inv(b *testing.B) {
+	var m Affine2
+	for range b.N {
+		m.Inv(aff)
+	}
+}
+
+func BenchmarkAffine2Rot(b *testing.B) {
+	var m Affine2
+	for range b.N {
+		m.Rot(aff, 0.5)
+	}
+}
+
+func BenchmarkAffine2MulVec2(b *testing.B) {
+	var r Vec2
+	v := V2(1, 2)
+	for range b.N {
+		r = aff.MulVec2(v)
+	}
+	_ = r
+}
+
+func BenchmarkAffine2MulRect(b *testing.B) {
+	var r Rectangle
+	rect := Rect(1, 2, 3, 4)
+	for range b.N {
+		r = aff.MulRect(rect)
+	}
+	_ = r
+}
+
+func BenchmarkVec3TransformPoint(b *testing.B) {
+	var r Vec3
+	v := V3(1, 2, 3)
+	for range b.N {
+		r = v.TransformPoint(a)
+	}
+	_ = r
+}
+
+func BenchmarkVec3TransformDir(b *testing.B) {
+	var r Vec3
+	v := V3(1, 2, 3)
+	for range b.N {
+		r = v.TransformDir(a)
+	}
+	_ = r
+}
+
+func BenchmarkVec4Add(b *testing.B) {
+	var r Vec4
+	v := V4(1, 2, 3, 4)
+	w := V4(5, 6, 7, 8)
+	for range b.N {
+		r = v.Add(w)
+	}
+	_ = r
+}
+
+func BenchmarkVec4Dot(b *testing.B) {
+	var r float32
+	v := V4(1, 2, 3, 4)
+	w := V4(5, 6, 7, 8)
+	for range b.N {
+		r = v.Dot(w)
+	}
+	_ = r
+}
+
+func BenchmarkVec4Norm(b *testing.B) {
+	var r Vec4
+	v := V4(1, 2, 3, 4)
+	for range b.N {
+		r = v.Norm()
+	}
+	_ = r
+}
+
+func BenchmarkVec4Lerp(b *testing.B) {
+	var r Vec4
+	v := V4(1, 2, 3, 4)
+	w := V4(5, 6, 7, 8)
+	for range b.N {
+		r = v.Lerp(w, 0.5)
+	}
+	_ = r
+}
+
+func BenchmarkVec4Transform(b *testing.B) {
+	var r Vec4
+	v := V4(1, 2, 3, 1)
+	for range b.N {
+		r = v.Transform(a)
+	}
+	_ = r
+}
+
+func BenchmarkProject(b *testing.B) {
+	var r Vec3
+	var proj Mat4
+	proj.Perspective(1, 1.5, 0.5, 20)
+	obj := V3(1, 2, -3)
+	viewport := Rect(0, 0, 640, 480)
+	for range b.N {
+		r = Project(obj, &id, &id, &proj, viewport)
+	}
+	_ = r
+}
+
+func BenchmarkUnproject(b *testing.B) {
+	var r Vec3
+	var proj Mat4
+	proj.Perspective(1, 1.5, 0.5, 20)
+	win := V3(100, 200, 0.5)
+	viewport := Rect(0, 0, 640, 480)
+	for range b.N {
+		r, _ = Unproject(win, &id, &id, &proj, viewport)
+	}
+	_ = r
+}
+
+func BenchmarkRayIntersectSphere(b *testing.B) {
+	r := Ray{V3(0, 0, 10), V3(0.1, 0, -1)}
+	for range b.N {
+		r.IntersectSphere(V3Zero, 2)
+	}
+}
+
+func BenchmarkRayIntersectAABB(b *testing.B) {
+	r := Ray{V3(-5, 0.5, 0.2), V3(1, 0.1, 0)}
+	for range b.N {
+		r.IntersectAABB(V3(-1, -1, -1), V3(1, 1, 1))
+	}
+}
+
+func BenchmarkRayIntersectTriangle(b *testing.B) {
+	r := Ray{V3(0.5, 0.5, 5), V3(0, 0, -1)}
+	for range b.N {
+		r.IntersectTriangle(V3(0, 0, 0), V3(2, 0, 0), V3(0, 2, 0))
+	}
+}
+
+func BenchmarkBoxUnion(b *testing.B) {
+	var r Box
+	p := Box{V3(0, 0, 0), V3(2, 2, 2)}
+	q := Box{V3(1, 1, 1), V3(3, 3, 3)}
+	for range b.N {
+		r = p.Union(q)
+	}
+	_ = r
+}
+
+func BenchmarkBoxTransform(b *testing.B) {
+	var r Box
+	p := Box{V3(0, 0, 0), V3(2, 2, 2)}
+	for range b.N {
+		r = p.Transform(a)
+	}
+	_ = r
+}
+
+func BenchmarkFrustumOf(b *testing.B) {
+	var r Frustum
+	var proj Mat4
+	proj.Perspective(1, 1.5, 0.5, 20)
+	for range b.N {
+		r = FrustumOf(&proj)
+	}
+	_ = r
+}
+
+func BenchmarkFrustumClassifyBox(b *testing.B) {
+	var proj Mat4
+	proj.Perspective(1, 1.5, 0.5, 20)
+	f := FrustumOf(&proj)
+	box := Box{V3(-1, -1, -6), V3(1, 1, -4)}
+	for range b.N {
+		f.ClassifyBox(box)
+	}
+}
+
+func BenchmarkVec2Floor(b *testing.B) {
+	var r Vec2i
+	v := V2(1.5, -2.5)
+	for range b.N {
+		r = v.Floor()
+	}
+	_ = r
+}
+
+func BenchmarkVec2iNeighbors8(b *testing.B) {
+	var r Vec2i
+	v := V2i(3, 4)
+	for range b.N {
+		for _, n := range v.Neighbors8() {
+			r = n
+		}
+	}
+	_ = r
+}
+
+func BenchmarkRectiCells(b *testing.B) {
+	var r Vec2i
+	rect := Recti{V2i(0, 0), V2i(16, 16)}
+	for range b.N {
+		rect.Cells(func(c Vec2i) bool {
+			r = c
+			return true
+		})
+	}
+	_ = r
+}
+
+func BenchmarkVec3AngleTo(b *testing.B) {
+	var r float32
+	v := V3(1, 2, 3)
+	w := V3(-2, 0.5, 1)
+	for range b.N {
+		r = v.AngleTo(w)
+	}
+	_ = r
+}
+
+func BenchmarkVec3MoveTowards(b *testing.B) {
+	var r Vec3
+	v := V3(1, 2, 3)
+	w := V3(4, 5, 6)
+	for range b.N {
+		r = v.MoveTowards(w, 0.5)
+	}
+	_ = r
+}
+
+func BenchmarkVec3Refract(b *testing.B) {
+	var r Vec3
+	v := V3(1, -1, 0).Norm()
+	n := V3(0, 1, 0)
+	for range b.N {
+		r, _ = v.Refract(n, 0.75)
+	}
+	_ = r
+}
+
+func BenchmarkVec3Rotate(b *testing.B) {
+	var r Vec3
+	v := V3(1, 2, 3)
+	axis := V3(0, 1, 1)
+	for range b.N {
+		r = v.Rotate(0.5, axis)
+	}
+	_ = r
+}
+
+func BenchmarkVec3Slerp(b *testing.B) {
+	var r Vec3
+	v := V3(1, 2, 3)
+	w := V3(-2, 0.5, 1)
+	for range b.N {
+		r = v.Slerp(w, 0.3)
+	}
+	_ = r
+}
+
+func BenchmarkVec3OrthonormalBasis(b *testing.B) {
+	var r, s Vec3
+	v := V3(1, 2, 3)
+	for range b.N {
+		r, s = v.OrthonormalBasis()
+	}
+	_, _ = r, s
+}
+
+func BenchmarkTransformPoint(b *testing.B) {
+	var r Vec3
+	tr := Transform{Translation: V3(1, 2, 3), Rotation: QuatRot(0.5, V3(0, 1, 0)), Scale: V3(2, 2, 2)}
+	v := V3(1, 2, 3)
+	for range b.N {
+		r = tr.Point(v)
+	}
+	_ = r
+}
+
+func BenchmarkTransformMat4(b *testing.B) {
+	var r Mat4
+	tr := Transform{Translation: V3(1, 2, 3), Rotation: QuatRot(0.5, V3(0, 1, 0)), Scale: V3(2, 2, 2)}
+	for range b.N {
+		r = tr.Mat4()
+	}
+	_ = r
+}
@@ -0,0 +1,237 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// A BlockLayout is a memory layout of GLSL uniform and shader storage
+// blocks as specified in section 7.6.2.2 "Standard Uniform Block Layout"
+// of the OpenGL specification.
+type BlockLayout int
+
+const (
+	// Std140 is the layout(std140) of uniform and shader storage blocks.
+	// The alignment of arrays and structs is rounded up to 16 bytes.
+	Std140 BlockLayout = iota + 1
+
+	// Std430 is the layout(std430) of shader storage blocks. It is like
+	// Std140, but the alignment of arrays and structs is not rounded up,
+	// so that e.g. the elements of a float array are tightly packed.
+	Std430
+)
+
+// String returns the GLSL name of the layout, like "std140".
+func (l BlockLayout) String() string {
+	switch l {
+	case Std140:
+		return "std140"
+	case Std430:
+		return "std430"
+	}
+	return fmt.Sprintf("BlockLayout(%d)", int(l))
+}
+
+// Append appends the value v to b according to the layout l and returns
+// the extended buffer. The offsets are relative to the beginning of the
+// appended data, which is meant to be the beginning of the block or the
+// buffer.
+//
+// The value is mapped to GLSL types as follows:
+//
+//	bool, int32, uint32, float32, float64: bool, int, uint, float, double
+//	Vec2, Vec3, Vec4, Quat:                vec2, vec3, vec4, vec4
+//	Vec2d, Vec3d, Vec4d, Quatd:            dvec2, dvec3, dvec4, dvec4
+//	Vec2i, Vec3i:                          ivec2, ivec3
+//	Mat3, Mat4, Mat3d, Mat4d:              mat3, mat4, dmat3, dmat4
+//	arrays and slices:                     arrays
+//	structs:                               structs
+//
+// The columns of a matrix m are m[0], m[1] and so on, as expected by
+// OpenGL. The fields of structs, including unexported ones, are laid out
+// in the order of their declaration. A struct passed as v is laid out
+// like a member of type struct, i.e. its size is padded to a multiple of
+// its alignment, so that it can describe the members of a whole block.
+// Numbers are written in little-endian byte order.
+//
+// Append returns an error for types without a GLSL equivalent, like int
+// or string, and for components of a Vec2i or Vec3i that overflow int32.
+func (l BlockLayout) Append(b []byte, v any) ([]byte, error) {
+	if l != Std140 && l != Std430 {
+		return b, fmt.Errorf("geom: invalid block layout %d", int(l))
+	}
+	e := blockEncoder{layout: l, buf: b, base: len(b)}
+	if err := e.encode(reflect.ValueOf(v)); err != nil {
+		return b, err
+	}
+	return e.buf, nil
+}
+
+// A blockShape describes a vector or matrix type of this package as a
+// number of columns of n components of the given size in bytes.
+type blockShape struct {
+	cols, n, size int
+}
+
+var blockShapes = map[reflect.Type]blockShape{
+	reflect.TypeFor[Vec2]():  {1, 2, 4},
+	reflect.TypeFor[Vec3]():  {1, 3, 4},
+	reflect.TypeFor[Vec4]():  {1, 4, 4},
+	reflect.TypeFor[Quat]():  {1, 4, 4},
+	reflect.TypeFor[Vec2d](): {1, 2, 8},
+	reflect.TypeFor[Vec3d](): {1, 3, 8},
+	reflect.TypeFor[Vec4d](): {1, 4, 8},
+	reflect.TypeFor[Quatd](): {1, 4, 8},
+	reflect.TypeFor[Vec2i](): {1, 2, 4},
+	reflect.TypeFor[Vec3i](): {1, 3, 4},
+	reflect.TypeFor[Mat3]():  {3, 3, 4},
+	reflect.TypeFor[Mat4]():  {4, 4, 4},
+	reflect.TypeFor[Mat3d](): {3, 3, 8},
+	reflect.TypeFor[Mat4d](): {4, 4, 8},
+}
+
+// arrayAlign returns the alignment of an array with elements of alignment
+// a, which is also the alignment of a struct with members of maximum
+// alignment a.
+func (l BlockLayout) arrayAlign(a int) int {
+	if l == Std140 {
+		return max(a, 16)
+	}
+	return a
+}
+
+// alignOf returns the base alignment of type t in bytes.
+func (l BlockLayout) alignOf(t reflect.Type) (int, error) {
+	if s, ok := blockShapes[t]; ok {
+		a := s.n * s.size
+		if s.n == 3 {
+			a = 4 * s.size
+		}
+		if s.cols > 1 {
+			// A matrix is laid out like an array of its columns.
+			a = l.arrayAlign(a)
+		}
+		return a, nil
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int32, reflect.Uint32, reflect.Float32:
+		return 4, nil
+	case reflect.Float64:
+		return 8, nil
+	case reflect.Array, reflect.Slice:
+		a, err := l.alignOf(t.Elem())
+		return l.arrayAlign(a), err
+	case reflect.Struct:
+		if t.NumField() == 0 {
+			return 0, fmt.Errorf("geom: %s layout of empty struct %s", l, t)
+		}
+		a := 0
+		for i := range t.NumField() {
+			fa, err := l.alignOf(t.Field(i).Type)
+			if err != nil {
+				return 0, err
+			}
+			a = max(a, fa)
+		}
+		return l.arrayAlign(a), nil
+	}
+	return 0, fmt.Errorf("geom: unsupported type %s for %s layout", t, l)
+}
+
+// blockEncoder appends values to a buffer according to a block layout.
+type blockEncoder struct {
+	layout BlockLayout
+	buf    []byte
+	base   int // start of the block in buf
+}
+
+// pad appends zero bytes until the offset is a multiple of align.
+func (e *blockEncoder) pad(align int) {
+	for (len(e.buf)-e.base)%align != 0 {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+// encode appends v at its aligned offset.
+func (e *blockEncoder) encode(v reflect.Value) error {
+	if !v.IsValid() {
+		return fmt.Errorf("geom: %s layout of nil value", e.layout)
+	}
+	t := v.Type()
+	a, err := e.layout.alignOf(t)
+	if err != nil {
+		return err
+	}
+	e.pad(a)
+	if s, ok := blockShapes[t]; ok {
+		if s.cols == 1 {
+			for i := range s.n {
+				if err := e.scalar(v.Field(i)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		for c := range s.cols {
+			e.pad(a)
+			for r := range s.n {
+				if err := e.scalar(v.Index(c).Index(r)); err != nil {
+					return err
+				}
+			}
+		}
+		e.pad(a)
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := range v.Len() {
+			if err := e.encode(v.Index(i)); err != nil {
+				return err
+			}
+			e.pad(a)
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if err := e.encode(v.Field(i)); err != nil {
+				return err
+			}
+		}
+		e.pad(a)
+	default:
+		return e.scalar(v)
+	}
+	return nil
+}
+
+// scalar appends the scalar v without alignment.
+func (e *blockEncoder) scalar(v reflect.Value) error {
+	le := binary.LittleEndian
+	switch v.Kind() {
+	case reflect.Bool:
+		var u uint32
+		if v.Bool() {
+			u = 1
+		}
+		e.buf = le.AppendUint32(e.buf, u)
+	case reflect.Int, reflect.Int32:
+		i := v.Int()
+		if i != int64(int32(i)) {
+			return fmt.Errorf("geom: %d overflows int32 in %s layout", i, e.layout)
+		}
+		e.buf = le.AppendUint32(e.buf, uint32(i))
+	case reflect.Uint32:
+		e.buf = le.AppendUint32(e.buf, uint32(v.Uint()))
+	case reflect.Float32:
+		e.buf = le.AppendUint32(e.buf, math.Float32bits(float32(v.Float())))
+	case reflect.Float64:
+		e.buf = le.AppendUint64(e.buf, math.Float64bits(v.Float()))
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+// The example block of section 7.6.2.2 of the OpenGL 4.6 specification,
+// with the bvec2 replaced by an ivec2, the uvec3 by an ivec3 and the
+// mat2x3 by an array of two vec3, which have the same layout:
+//
+//	layout(std140) uniform Example {
+//		float a;
+//		vec2 b;
+//		vec3 c;
+//		struct {
+//			int d;
+//			bvec2 e;
+//		} f;
+//		float g;
+//		float h[2];
+//		mat2x3 i;
+//		struct {
+//			uvec3 j;
+//			vec2 k;
+//			float l[2];
+//			vec2 m;
+//			mat3 n[2];
+//		} o[2];
+//	};
+type specBlockF struct {
+	D int32
+	E Vec2i
+}
+
+type specBlockO struct {
+	J Vec3i
+	K Vec2
+	L [2]float32
+	M Vec2
+	N [2]Mat3
+}
+
+type specBlock struct {
+	A float32
+	B Vec2
+	C Vec3
+	F specBlockF
+	G float32
+	H [2]float32
+	I [2]Vec3
+	O [2]specBlockO
+}
+
+func TestBlockLayoutAppend(t *testing.T) {
+	// Each scalar gets a distinct value n, which is stored as float32(n)
+	// or int32(n).
+	n := 0
+	next := func() float32 { n++; return float32(n) }
+	nexti := func() int { n++; return n }
+	var blk specBlock
+	blk.A = next()
+	blk.B = V2(next(), next())
+	blk.C = V3(next(), next(), next())
+	blk.F.D = int32(nexti())
+	blk.F.E = V2i(nexti(), nexti())
+	blk.G = next()
+	blk.H = [2]float32{next(), next()}
+	blk.I = [2]Vec3{V3(next(), next(), next()), V3(next(), next(), next())}
+	for k := range blk.O {
+		o := &blk.O[k]
+		o.J = V3i(nexti(), nexti(), nexti())
+		o.K = V2(next(), next())
+		o.L = [2]float32{next(), next()}
+		o.M = V2(next(), next())
+		for i := range o.N {
+			for c := range 3 {
+				o.N[i][c] = [3]float32{next(), next(), next()}
+			}
+		}
+	}
+
+	// The offsets of the scalars in the order of their values, as given
+	// by the specification for std140 and computed by hand for std430.
+	type member struct {
+		isInt   bool
+		offsets []int // std140, std430
+	}
+	vec := func(isInt bool, n, off140, off430 int) []member {
+		ms := make([]member, n)
+		for i := range ms {
+			ms[i] = member{isInt, []int{off140 + 4*i, off430 + 4*i}}
+		}
+		return ms
+	}
+	var members []member
+	add := func(ms ...[]member) {
+		for _, m := range ms {
+			members = append(members, m...)
+		}
+	}
+	add(vec(false, 1, 0, 0), vec(false, 2, 8, 8), vec(false, 3, 16, 16))
+	add(vec(true, 1, 32, 32), vec(true, 2, 40, 40))
+	add(vec(false, 1, 48, 48))
+	add(vec(false, 1, 64, 52), vec(false, 1, 80, 56))
+	add(vec(false, 3, 96, 64), vec(false, 3, 112, 80))
+	for _, o := range [][2]int{{128, 96}, {304, 240}} {
+		add(vec(true, 3, o[0], o[1]))
+		add(vec(false, 2, o[0]+16, o[1]+16))
+		add(vec(false, 1, o[0]+32, o[1]+24), vec(false, 1, o[0]+48, o[1]+28))
+		add(vec(false, 2, o[0]+64, o[1]+32))
+		for c := range 6 {
+			add(vec(false, 3, o[0]+80+16*c, o[1]+48+16*c))
+		}
+	}
+	if len(members) != n {
+		t.Fatalf("test has %d offsets for %d values", len(members), n)
+	}
+
+	for li, tt := range []struct {
+		layout BlockLayout
+		size   int
+	}{
+		{Std140, 480},
+		{Std430, 384},
+	} {
+		want := make([]byte, tt.size)
+		for i, m := range members {
+			u := math.Float32bits(float32(i + 1))
+			if m.isInt {
+				u = uint32(i + 1)
+			}
+			binary.LittleEndian.PutUint32(want[m.offsets[li]:], u)
+		}
+		prefix := []byte{0xff, 0xff}
+		b, err := tt.layout.Append(prefix, blk)
+		if err != nil {
+			t.Errorf("%s: Append: unexpected error: %v", tt.layout, err)
+			continue
+		}
+		if !bytes.Equal(b[:2], prefix) {
+			t.Errorf("%s: Append modified prefix: % x", tt.layout, b[:2])
+		}
+		if got := b[2:]; !bytes.Equal(got, want) {
+			t.Errorf("%s: Append =\n% x\nwant\n% x", tt.layout, got, want)
+		}
+	}
+}
+
+func TestBlockLayoutAppendTypes(t *testing.T) {
+	tests := []struct {
+		v              any
+		std140, std430 int
+	}{
+		{float32(1), 4, 4},
+		{true, 4, 4},
+		{V3(1, 2, 3), 12, 12},
+		{V3d(1, 2, 3), 24, 24},
+		{QuatID, 16, 16},
+		{Mat4(id), 64, 64},
+		{Mat3(id3), 48, 48},
+		{Mat4d{}, 128, 128},
+		{[]float32{1, 2, 3}, 48, 12},
+		{[3]Vec2{}, 48, 24},
+		{[2]Vec3{}, 32, 32},
+		{struct{ X float32 }{}, 16, 4},
+		{struct {
+			V Vec3
+			F float32
+		}{}, 16, 16},
+		{struct {
+			F float32
+			D float64
+		}{}, 16, 16},
+		{Rect(0, 0, 1, 1), 16, 16},
+		{struct {
+			F float32
+			V Vec3d
+		}{}, 64, 64},
+	}
+	for _, tt := range tests {
+		for _, l := range []struct {
+			layout BlockLayout
+			size   int
+		}{{Std140, tt.std140}, {Std430, tt.std430}} {
+			b, err := l.layout.Append(nil, tt.v)
+			if err != nil || len(b) != l.size {
+				t.Errorf("%s.Append(%T) has %d bytes, %v, want %d bytes", l.layout, tt.v, len(b), err, l.size)
+			}
+		}
+	}
+
+	b, _ := Std140.Append(nil, Mat3{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}})
+	for c := range 3 {
+		for r := range 3 {
+			off := 16*c + 4*r
+			if f := math.Float32frombits(binary.LittleEndian.Uint32(b[off:])); f != float32(3*c+r+1) {
+				t.Errorf("Mat3 element [%d][%d] at offset %d = %g, want %d", c, r, off, f, 3*c+r+1)
+			}
+		}
+	}
+}
+
+func TestBlockLayoutAppendErrors(t *testing.T) {
+	tests := []struct {
+		layout BlockLayout
+		v      any
+	}{
+		{Std140, 1},
+		{Std140, "a"},
+		{Std430, struct{ P *Vec3 }{}},
+		{Std430, struct{}{}},
+		{Std140, nil},
+		{Std140, V2i(1<<31, 0)},
+		{BlockLayout(0), float32(1)},
+	}
+	for _, tt := range tests {
+		b, err := tt.layout.Append([]byte{1}, tt.v)
+		if err == nil {
+			t.Errorf("%s.Append(%#v): expected error", tt.layout, tt.v)
+		}
+		if len(b) != 1 {
+			t.Errorf("%s.Append(%#v) returned %d bytes, want the original buffer", tt.layout, tt.v, len(b))
+		}
+	}
+	if s := BlockLayout(3).String(); s != "BlockLayout(3)" {
+		t.Errorf("BlockLayout(3).String() = %q", s)
+	}
+}
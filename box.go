@@ -0,0 +1,142 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import "math"
+
+// A Box is an axis-aligned bounding box in 3-dimensional euclidean space.
+// It contains the points with Min.X <= X <= Max.X, Min.Y <= Y <= Max.Y and
+// Min.Z <= Z <= Max.Z. It is well-formed if Min.X <= Max.X and likewise for
+// Y and Z. A Box that is not well-formed is empty.
+type Box struct {
+	Min Vec3
+	Max Vec3
+}
+
+// inf is positive infinity as float32.
+var inf = float32(math.Inf(1))
+
+// emptyBox is the empty box that is the identity element of Union.
+var emptyBox = Box{
+	Min: Vec3{inf, inf, inf},
+	Max: Vec3{-inf, -inf, -inf},
+}
+
+// BoxOf returns the smallest box containing all the given points. If no
+// points are given, the result is an empty box.
+func BoxOf(pts ...Vec3) Box {
+	b := emptyBox
+	for _, p := range pts {
+		b = b.Expand(p)
+	}
+	return b
+}
+
+// Contains reports whether the box contains point pt.
+func (b Box) Contains(pt Vec3) bool {
+	return (b.Min.X <= pt.X && pt.X <= b.Max.X) &&
+		(b.Min.Y <= pt.Y && pt.Y <= b.Max.Y) &&
+		(b.Min.Z <= pt.Z && pt.Z <= b.Max.Z)
+}
+
+// ContainsBox reports whether box c is entirely contained in b.
+// An empty box is contained in any box.
+func (b Box) ContainsBox(c Box) bool {
+	if c.Empty() {
+		return true
+	}
+	return b.Contains(c.Min) && b.Contains(c.Max)
+}
+
+// Empty reports whether the box contains no points.
+func (b Box) Empty() bool {
+	return b.Min.X > b.Max.X || b.Min.Y > b.Max.Y || b.Min.Z > b.Max.Z
+}
+
+// Canon returns the canonical version of b. The returned box has minimum
+// and maximum coordinates swapped if necessary so that it is well-formed.
+func (b Box) Canon() Box {
+	return Box{Min: b.Min.Min(b.Max), Max: b.Min.Max(b.Max)}
+}
+
+// Intersect returns the largest box contained by both b and c. If the two
+// boxes do not overlap, the result is empty.
+func (b Box) Intersect(c Box) Box {
+	return Box{Min: b.Min.Max(c.Min), Max: b.Max.Min(c.Max)}
+}
+
+// Union returns the smallest box that contains both b and c.
+// An empty box does not contribute to the union.
+func (b Box) Union(c Box) Box {
+	if b.Empty() {
+		return c
+	}
+	if c.Empty() {
+		return b
+	}
+	return Box{Min: b.Min.Min(c.Min), Max: b.Max.Max(c.Max)}
+}
+
+// Overlaps reports whether b and c have a non-empty intersection.
+func (b Box) Overlaps(c Box) bool {
+	return !b.Intersect(c).Empty()
+}
+
+// Center returns the center point of the box.
+func (b Box) Center() Vec3 {
+	return b.Min.Add(b.Max).Mul(0.5)
+}
+
+// Size returns the dimensions (width, height and depth) of the box.
+func (b Box) Size() Vec3 {
+	return b.Max.Sub(b.Min)
+}
+
+// Extents returns the half-dimensions of the box, i.e. the distances from
+// the center to the faces of the box.
+func (b Box) Extents() Vec3 {
+	return b.Size().Mul(0.5)
+}
+
+// Expand returns the smallest box that contains both b and point pt.
+func (b Box) Expand(pt Vec3) Box {
+	return Box{Min: b.Min.Min(pt), Max: b.Max.Max(pt)}
+}
+
+// Grow returns the box b enlarged by margin on every side. A negative
+// margin shrinks the box.
+func (b Box) Grow(margin float32) Box {
+	m := Vec3{margin, margin, margin}
+	return Box{Min: b.Min.Sub(m), Max: b.Max.Add(m)}
+}
+
+// Transform returns the smallest axis-aligned box that encloses box b
+// transformed by the affine transformation matrix m, using Arvo's method.
+func (b Box) Transform(m *Mat4) Box {
+	if b.Empty() {
+		return b
+	}
+	lo := [3]float32{b.Min.X, b.Min.Y, b.Min.Z}
+	hi := [3]float32{b.Max.X, b.Max.Y, b.Max.Z}
+	rMin := [3]float32{m[3][0], m[3][1], m[3][2]}
+	rMax := rMin
+	for i := range 3 {
+		for j := range 3 {
+			e := m[j][i] * lo[j]
+			f := m[j][i] * hi[j]
+			rMin[i] += min(e, f)
+			rMax[i] += max(e, f)
+		}
+	}
+	return Box{
+		Min: Vec3{rMin[0], rMin[1], rMin[2]},
+		Max: Vec3{rMax[0], rMax[1], rMax[2]},
+	}
+}
+
+// String returns a string representation of b like "(0, 0, 0)-(1, 2, 3)".
+func (b Box) String() string {
+	return b.Min.String() + "-" + b.Max.String()
+}
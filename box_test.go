@@ -0,0 +1,222 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBoxOf(t *testing.T) {
+	tests := []struct {
+		pts  []Vec3
+		want Box
+	}{
+		{[]Vec3{V3(1, 2, 3)}, Box{V3(1, 2, 3), V3(1, 2, 3)}},
+		{[]Vec3{V3(1, 2, 3), V3(-1, 5, 0)}, Box{V3(-1, 2, 0), V3(1, 5, 3)}},
+		{[]Vec3{V3(0, 0, 0), V3(4, -2, 1), V3(-3, 1, 7), V3(2, 2, 2)}, Box{V3(-3, -2, 0), V3(4, 2, 7)}},
+	}
+	for _, tt := range tests {
+		if b := BoxOf(tt.pts...); !boxNearEq(b, tt.want) {
+			t.Errorf("BoxOf(%v) = %v, want %v", tt.pts, b, tt.want)
+		}
+	}
+	if b := BoxOf(); !b.Empty() {
+		t.Errorf("BoxOf() = %v, want empty box", b)
+	}
+}
+
+func TestBoxContains(t *testing.T) {
+	b := Box{V3(-1, 0, 2), V3(1, 3, 4)}
+	tests := []struct {
+		pt   Vec3
+		want bool
+	}{
+		{V3(0, 1, 3), true},
+		{V3(-1, 0, 2), true},
+		{V3(1, 3, 4), true},
+		{V3(1.1, 1, 3), false},
+		{V3(0, -0.1, 3), false},
+		{V3(0, 1, 4.1), false},
+	}
+	for _, tt := range tests {
+		if x := b.Contains(tt.pt); x != tt.want {
+			t.Errorf("%v.Contains(%s) = %v, want %v", b, tt.pt, x, tt.want)
+		}
+	}
+}
+
+func TestBoxContainsBox(t *testing.T) {
+	b := Box{V3(-1, 0, 2), V3(1, 3, 4)}
+	tests := []struct {
+		c    Box
+		want bool
+	}{
+		{b, true},
+		{Box{V3(0, 1, 3), V3(0.5, 2, 3.5)}, true},
+		{Box{V3(0, 1, 3), V3(2, 2, 3.5)}, false},
+		{emptyBox, true},
+	}
+	for _, tt := range tests {
+		if x := b.ContainsBox(tt.c); x != tt.want {
+			t.Errorf("%v.ContainsBox(%v) = %v, want %v", b, tt.c, x, tt.want)
+		}
+	}
+}
+
+func TestBoxEmptyCanon(t *testing.T) {
+	tests := []struct {
+		b     Box
+		empty bool
+		canon Box
+	}{
+		{Box{V3(0, 0, 0), V3(1, 1, 1)}, false, Box{V3(0, 0, 0), V3(1, 1, 1)}},
+		{Box{V3(0, 0, 0), V3(0, 0, 0)}, false, Box{V3(0, 0, 0), V3(0, 0, 0)}},
+		{Box{V3(1, 0, 0), V3(0, 1, 1)}, true, Box{V3(0, 0, 0), V3(1, 1, 1)}},
+		{Box{V3(0, 0, 5), V3(1, 1, -2)}, true, Box{V3(0, 0, -2), V3(1, 1, 5)}},
+	}
+	for _, tt := range tests {
+		if x := tt.b.Empty(); x != tt.empty {
+			t.Errorf("%v.Empty() = %v, want %v", tt.b, x, tt.empty)
+		}
+		if x := tt.b.Canon(); !boxNearEq(x, tt.canon) {
+			t.Errorf("%v.Canon() = %v, want %v", tt.b, x, tt.canon)
+		}
+	}
+}
+
+func TestBoxIntersectUnion(t *testing.T) {
+	tests := []struct {
+		b, c      Box
+		intersect Box
+		union     Box
+		overlaps  bool
+	}{
+		{
+			Box{V3(0, 0, 0), V3(2, 2, 2)}, Box{V3(1, 1, 1), V3(3, 3, 3)},
+			Box{V3(1, 1, 1), V3(2, 2, 2)}, Box{V3(0, 0, 0), V3(3, 3, 3)}, true,
+		},
+		{
+			Box{V3(0, 0, 0), V3(4, 4, 4)}, Box{V3(1, 1, 1), V3(2, 2, 2)},
+			Box{V3(1, 1, 1), V3(2, 2, 2)}, Box{V3(0, 0, 0), V3(4, 4, 4)}, true,
+		},
+		{
+			Box{V3(0, 0, 0), V3(1, 1, 1)}, Box{V3(1, 0, 0), V3(2, 1, 1)},
+			Box{V3(1, 0, 0), V3(1, 1, 1)}, Box{V3(0, 0, 0), V3(2, 1, 1)}, true,
+		},
+		{
+			Box{V3(0, 0, 0), V3(1, 1, 1)}, Box{V3(2, 0, 0), V3(3, 1, 1)},
+			Box{V3(2, 0, 0), V3(1, 1, 1)}, Box{V3(0, 0, 0), V3(3, 1, 1)}, false,
+		},
+	}
+	for _, tt := range tests {
+		if x := tt.b.Intersect(tt.c); !boxNearEq(x, tt.intersect) {
+			t.Errorf("%v.Intersect(%v) = %v, want %v", tt.b, tt.c, x, tt.intersect)
+		}
+		if x := tt.b.Union(tt.c); !boxNearEq(x, tt.union) {
+			t.Errorf("%v.Union(%v) = %v, want %v", tt.b, tt.c, x, tt.union)
+		}
+		if x := tt.b.Overlaps(tt.c); x != tt.overlaps {
+			t.Errorf("%v.Overlaps(%v) = %v, want %v", tt.b, tt.c, x, tt.overlaps)
+		}
+	}
+
+	b := Box{V3(1, 2, 3), V3(4, 5, 6)}
+	if x := b.Union(emptyBox); x != b {
+		t.Errorf("%v.Union(empty) = %v, want %v", b, x, b)
+	}
+	if x := emptyBox.Union(b); x != b {
+		t.Errorf("empty.Union(%v) = %v, want %v", b, x, b)
+	}
+}
+
+func TestBoxDimensions(t *testing.T) {
+	tests := []struct {
+		b                     Box
+		center, size, extents Vec3
+	}{
+		{Box{V3(0, 0, 0), V3(2, 4, 6)}, V3(1, 2, 3), V3(2, 4, 6), V3(1, 2, 3)},
+		{Box{V3(-1, -2, 3), V3(1, 2, 3)}, V3(0, 0, 3), V3(2, 4, 0), V3(1, 2, 0)},
+	}
+	for _, tt := range tests {
+		if x := tt.b.Center(); !x.NearEq(tt.center) {
+			t.Errorf("%v.Center() = %s, want %s", tt.b, x, tt.center)
+		}
+		if x := tt.b.Size(); !x.NearEq(tt.size) {
+			t.Errorf("%v.Size() = %s, want %s", tt.b, x, tt.size)
+		}
+		if x := tt.b.Extents(); !x.NearEq(tt.extents) {
+			t.Errorf("%v.Extents() = %s, want %s", tt.b, x, tt.extents)
+		}
+	}
+}
+
+func TestBoxExpandGrow(t *testing.T) {
+	b := Box{V3(0, 0, 0), V3(1, 1, 1)}
+	tests := []struct {
+		pt   Vec3
+		want Box
+	}{
+		{V3(0.5, 0.5, 0.5), b},
+		{V3(2, -1, 0.5), Box{V3(0, -1, 0), V3(2, 1, 1)}},
+	}
+	for _, tt := range tests {
+		if x := b.Expand(tt.pt); !boxNearEq(x, tt.want) {
+			t.Errorf("%v.Expand(%s) = %v, want %v", b, tt.pt, x, tt.want)
+		}
+	}
+	if x, want := emptyBox.Expand(V3(1, 2, 3)), (Box{V3(1, 2, 3), V3(1, 2, 3)}); x != want {
+		t.Errorf("empty.Expand(...) = %v, want %v", x, want)
+	}
+	if x, want := b.Grow(0.5), (Box{V3(-0.5, -0.5, -0.5), V3(1.5, 1.5, 1.5)}); !boxNearEq(x, want) {
+		t.Errorf("%v.Grow(0.5) = %v, want %v", b, x, want)
+	}
+	if x, want := b.Grow(-0.25), (Box{V3(0.25, 0.25, 0.25), V3(0.75, 0.75, 0.75)}); !boxNearEq(x, want) {
+		t.Errorf("%v.Grow(-0.25) = %v, want %v", b, x, want)
+	}
+}
+
+func TestBoxTransform(t *testing.T) {
+	var rot, trans, scale Mat4
+	rot.ID().Rot(&rot, math.Pi/4, V3UnitZ)
+	trans.ID().Translate(&trans, V3(2.5, 3, -1))
+	scale.ID().Scale(&scale, V3(2, -3, 1))
+
+	b := Box{V3(-1, -1, 0), V3(1, 1, 2)}
+	tests := []struct {
+		m    *Mat4
+		want Box
+	}{
+		{&id, b},
+		{&trans, Box{V3(1.5, 2, -1), V3(3.5, 4, 1)}},
+		{&scale, Box{V3(-2, -3, 0), V3(2, 3, 2)}},
+		{&rot, Box{V3(-1.4142135, -1.4142135, 0), V3(1.4142135, 1.4142135, 2)}},
+	}
+	for _, tt := range tests {
+		if x := b.Transform(tt.m); !boxNearEq(x, tt.want) {
+			t.Errorf("%v.Transform(%v) = %v, want %v", b, *tt.m, x, tt.want)
+		}
+	}
+
+	// The transformed box must contain all transformed corners.
+	var m Mat4
+	m.ID().Translate(&m, V3(1, -2, 3)).Rot(&m, 0.7, V3(1, 2, 3)).Scale(&m, V3(2, 0.5, -1))
+	c := Box{V3(-1, 2, 0.5), V3(3, 4, 1.5)}
+	tb := c.Transform(&m).Grow(epsilon)
+	for _, p := range []Vec3{
+		V3(c.Min.X, c.Min.Y, c.Min.Z), V3(c.Max.X, c.Min.Y, c.Min.Z),
+		V3(c.Min.X, c.Max.Y, c.Min.Z), V3(c.Max.X, c.Max.Y, c.Min.Z),
+		V3(c.Min.X, c.Min.Y, c.Max.Z), V3(c.Max.X, c.Min.Y, c.Max.Z),
+		V3(c.Min.X, c.Max.Y, c.Max.Z), V3(c.Max.X, c.Max.Y, c.Max.Z),
+	} {
+		if tp := p.Transform(&m); !tb.Contains(tp) {
+			t.Errorf("%v.Transform(%v) = %v does not contain transformed corner %s", c, m, tb, tp)
+		}
+	}
+}
+
+func boxNearEq(a, b Box) bool {
+	return a.Min.NearEq(b.Min) && a.Max.NearEq(b.Max)
+}
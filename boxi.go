@@ -0,0 +1,120 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+// A Boxi is an axis-aligned box of integer grid cells, e.g. voxels. Like
+// Recti and unlike Box it is half-open: it contains the cells with
+// Min.X <= X < Max.X, Min.Y <= Y < Max.Y and Min.Z <= Z < Max.Z. A Boxi
+// that is not well-formed or has a zero extent along any axis is empty.
+type Boxi struct {
+	Min Vec3i
+	Max Vec3i
+}
+
+// Contains reports whether the box contains cell p.
+func (b Boxi) Contains(p Vec3i) bool {
+	return (b.Min.X <= p.X && p.X < b.Max.X) &&
+		(b.Min.Y <= p.Y && p.Y < b.Max.Y) &&
+		(b.Min.Z <= p.Z && p.Z < b.Max.Z)
+}
+
+// ContainsBox reports whether box c is entirely contained in b.
+// An empty box is contained in any box.
+func (b Boxi) ContainsBox(c Boxi) bool {
+	if c.Empty() {
+		return true
+	}
+	return b.Min.X <= c.Min.X && c.Max.X <= b.Max.X &&
+		b.Min.Y <= c.Min.Y && c.Max.Y <= b.Max.Y &&
+		b.Min.Z <= c.Min.Z && c.Max.Z <= b.Max.Z
+}
+
+// Size returns the extent of b along each axis.
+func (b Boxi) Size() Vec3i {
+	return b.Max.Sub(b.Min)
+}
+
+// Volume returns the number of cells in b, or 0 if b is empty.
+func (b Boxi) Volume() int {
+	if b.Empty() {
+		return 0
+	}
+	s := b.Size()
+	return s.X * s.Y * s.Z
+}
+
+// Empty reports whether the box contains no cells.
+func (b Boxi) Empty() bool {
+	return b.Min.X >= b.Max.X || b.Min.Y >= b.Max.Y || b.Min.Z >= b.Max.Z
+}
+
+// Canon returns the canonical version of b. The returned box has minimum
+// and maximum coordinates swapped if necessary so that it is well-formed.
+func (b Boxi) Canon() Boxi {
+	return Boxi{Min: b.Min.Min(b.Max), Max: b.Min.Max(b.Max)}
+}
+
+// Intersect returns the largest box contained by both b and c. If the two
+// boxes do not overlap then the zero box will be returned.
+func (b Boxi) Intersect(c Boxi) Boxi {
+	i := Boxi{Min: b.Min.Max(c.Min), Max: b.Max.Min(c.Max)}
+	if i.Empty() {
+		return Boxi{}
+	}
+	return i
+}
+
+// Union returns the smallest box that contains both b and c. If one of
+// them is empty, the other one is returned.
+func (b Boxi) Union(c Boxi) Boxi {
+	if b.Empty() {
+		return c
+	}
+	if c.Empty() {
+		return b
+	}
+	return Boxi{Min: b.Min.Min(c.Min), Max: b.Max.Max(c.Max)}
+}
+
+// Overlaps reports whether b and c have a non-empty intersection.
+func (b Boxi) Overlaps(c Boxi) bool {
+	return !b.Intersect(c).Empty()
+}
+
+// Add returns the box b translated by v.
+func (b Boxi) Add(v Vec3i) Boxi {
+	return Boxi{Min: b.Min.Add(v), Max: b.Max.Add(v)}
+}
+
+// Sub returns the box b translated by -v.
+func (b Boxi) Sub(v Vec3i) Boxi {
+	return Boxi{Min: b.Min.Sub(v), Max: b.Max.Sub(v)}
+}
+
+// Cells calls yield for each cell contained in b, ordered by Z, then Y,
+// then X, i.e. X varies fastest, until yield returns false. It does not
+// call yield if b is empty. Like Recti.Cells, the method value can be used
+// as an iterator with Go 1.23 or later.
+func (b Boxi) Cells(yield func(Vec3i) bool) {
+	for z := b.Min.Z; z < b.Max.Z; z++ {
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				if !yield(Vec3i{x, y, z}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Box returns the volume covered by the cells of b as a Box.
+func (b Boxi) Box() Box {
+	return Box{Min: b.Min.Vec3(), Max: b.Max.Vec3()}
+}
+
+// String returns a string representation of b like "(0, 0, 0)-(4, 4, 2)".
+func (b Boxi) String() string {
+	return b.Min.String() + "-" + b.Max.String()
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBoxiContains(t *testing.T) {
+	b := Boxi{V3i(0, 0, 0), V3i(2, 3, 4)}
+	tests := []struct {
+		p    Vec3i
+		want bool
+	}{
+		{V3i(0, 0, 0), true},
+		{V3i(1, 2, 3), true},
+		{V3i(2, 2, 3), false},
+		{V3i(1, 3, 3), false},
+		{V3i(1, 2, 4), false},
+		{V3i(1, 2, -1), false},
+	}
+	for _, tt := range tests {
+		if x := b.Contains(tt.p); x != tt.want {
+			t.Errorf("%s.Contains(%s) = %v, want %v", b, tt.p, x, tt.want)
+		}
+	}
+	if !b.ContainsBox(Boxi{V3i(1, 1, 1), V3i(2, 3, 4)}) {
+		t.Errorf("%s.ContainsBox((1, 1, 1)-(2, 3, 4)) = false, want true", b)
+	}
+	if b.ContainsBox(Boxi{V3i(1, 1, 1), V3i(2, 3, 5)}) {
+		t.Errorf("%s.ContainsBox((1, 1, 1)-(2, 3, 5)) = true, want false", b)
+	}
+}
+
+func TestBoxiVolume(t *testing.T) {
+	tests := []struct {
+		b      Boxi
+		volume int
+		empty  bool
+	}{
+		{Boxi{V3i(0, 0, 0), V3i(2, 3, 4)}, 24, false},
+		{Boxi{V3i(0, 0, 0), V3i(2, 3, 0)}, 0, true},
+		{Boxi{V3i(0, 0, 0), V3i(-2, 3, 4)}, 0, true},
+	}
+	for _, tt := range tests {
+		if x := tt.b.Volume(); x != tt.volume {
+			t.Errorf("%s.Volume() = %d, want %d", tt.b, x, tt.volume)
+		}
+		if x := tt.b.Empty(); x != tt.empty {
+			t.Errorf("%s.Empty() = %v, want %v", tt.b, x, tt.empty)
+		}
+	}
+}
+
+func TestBoxiSetOps(t *testing.T) {
+	b := Boxi{V3i(0, 0, 0), V3i(4, 4, 4)}
+	c := Boxi{V3i(2, 3, -1), V3i(6, 5, 1)}
+	if x := b.Intersect(c); x != (Boxi{V3i(2, 3, 0), V3i(4, 4, 1)}) {
+		t.Errorf("%s.Intersect(%s) = %s", b, c, x)
+	}
+	if x := b.Union(c); x != (Boxi{V3i(0, 0, -1), V3i(6, 5, 4)}) {
+		t.Errorf("%s.Union(%s) = %s", b, c, x)
+	}
+	if !b.Overlaps(c) {
+		t.Errorf("%s.Overlaps(%s) = false, want true", b, c)
+	}
+	d := Boxi{V3i(4, 0, 0), V3i(5, 4, 4)}
+	if b.Overlaps(d) {
+		t.Errorf("%s.Overlaps(%s) = true, want false", b, d)
+	}
+	if x := b.Intersect(d); x != (Boxi{}) {
+		t.Errorf("%s.Intersect(%s) = %s, want zero box", b, d, x)
+	}
+	if x := b.Union(Boxi{}); x != b {
+		t.Errorf("%s.Union(zero box) = %s, want %s", b, x, b)
+	}
+	if x := (Boxi{V3i(4, 4, 4), V3i(0, 0, 0)}).Canon(); x != b {
+		t.Errorf("Canon() = %s, want %s", x, b)
+	}
+	if x := b.Add(V3i(1, 2, 3)).Sub(V3i(1, 2, 3)); x != b {
+		t.Errorf("%s.Add(v).Sub(v) = %s, want %s", b, x, b)
+	}
+}
+
+func TestBoxiCells(t *testing.T) {
+	b := Boxi{V3i(0, 0, 0), V3i(2, 1, 2)}
+	want := []Vec3i{{0, 0, 0}, {1, 0, 0}, {0, 0, 1}, {1, 0, 1}}
+	if x := collect(b.Cells); !slices.Equal(x, want) {
+		t.Errorf("%s.Cells() = %v, want %v", b, x, want)
+	}
+	if x := b.Box(); x != (Box{V3(0, 0, 0), V3(2, 1, 2)}) {
+		t.Errorf("%s.Box() = %v", b, x)
+	}
+}
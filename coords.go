@@ -0,0 +1,163 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+// An Axis is the positive or negative direction of a coordinate axis.
+type Axis int
+
+// The directions of the coordinate axes.
+const (
+	PosX Axis = iota
+	NegX
+	PosY
+	NegY
+	PosZ
+	NegZ
+)
+
+// String returns the name of the axis, like "+X" or "-Z".
+func (a Axis) String() string {
+	if a < PosX || a > NegZ {
+		return "invalid axis"
+	}
+	return [...]string{"+X", "-X", "+Y", "-Y", "+Z", "-Z"}[a]
+}
+
+// unit returns the unit vector in the direction of a.
+func unit[T Float](a Axis) Vector3[T] {
+	var v Vector3[T]
+	s := T(1)
+	if a%2 == 1 {
+		s = -1
+	}
+	switch a / 2 {
+	case 0:
+		v.X = s
+	case 1:
+		v.Y = s
+	case 2:
+		v.Z = s
+	}
+	return v
+}
+
+// A CoordSystem is a convention of 3-dimensional coordinates, given by the
+// axes pointing right, up and forward for a viewer looking at the front of
+// a model, like a camera in its default orientation.
+type CoordSystem struct {
+	Right, Up, Forward Axis
+}
+
+// Common coordinate systems of graphics APIs, file formats and tools.
+var (
+	// YUpRightHanded is used by OpenGL, Vulkan, glTF and Maya.
+	YUpRightHanded = CoordSystem{Right: PosX, Up: PosY, Forward: NegZ}
+
+	// YUpLeftHanded is used by Direct3D and Unity.
+	YUpLeftHanded = CoordSystem{Right: PosX, Up: PosY, Forward: PosZ}
+
+	// ZUpRightHanded is used by Blender and 3ds Max.
+	ZUpRightHanded = CoordSystem{Right: PosX, Up: PosZ, Forward: PosY}
+
+	// ZUpLeftHanded is used by Unreal Engine.
+	ZUpLeftHanded = CoordSystem{Right: PosY, Up: PosZ, Forward: PosX}
+)
+
+// Valid reports whether the axes of c are valid and belong to different
+// dimensions.
+func (c CoordSystem) Valid() bool {
+	for _, a := range []Axis{c.Right, c.Up, c.Forward} {
+		if a < PosX || a > NegZ {
+			return false
+		}
+	}
+	return c.Right/2 != c.Up/2 && c.Right/2 != c.Forward/2 && c.Up/2 != c.Forward/2
+}
+
+// Handedness returns the handedness of c, which must be valid.
+func (c CoordSystem) Handedness() Handedness {
+	r, u, f := unit[float32](c.Right), unit[float32](c.Up), unit[float32](c.Forward)
+	if r.Cross(u).Dot(f) > 0 {
+		return LeftHanded
+	}
+	return RightHanded
+}
+
+// A BasisChange converts coordinates from one coordinate system to another,
+// e.g. when importing a model from a file format with a different
+// convention. The conversions keep the semantic directions right, up and
+// forward. Create it with NewBasisChange.
+type BasisChange[T Float] struct {
+	m   Matrix3[T] // converts the coordinates of vectors
+	det T          // determinant of m, -1 if the handedness changes
+}
+
+// NewBasisChange returns the change of basis from the coordinate system
+// from to the coordinate system to. It panics if one of them is not valid.
+func NewBasisChange[T Float](from, to CoordSystem) BasisChange[T] {
+	if !from.Valid() || !to.Valid() {
+		panic("geom: invalid coordinate system")
+	}
+	// The matrix is the sum of the outer products of the corresponding
+	// target and source axes.
+	var c BasisChange[T]
+	for _, p := range [][2]Axis{{from.Right, to.Right}, {from.Up, to.Up}, {from.Forward, to.Forward}} {
+		a, b := unit[T](p[0]), unit[T](p[1])
+		av, bv := [3]T{a.X, a.Y, a.Z}, [3]T{b.X, b.Y, b.Z}
+		for j := range 3 {
+			for i := range 3 {
+				c.m[j][i] += bv[i] * av[j]
+			}
+		}
+	}
+	c.det = c.m.Det()
+	return c
+}
+
+// Vec3 returns the point or direction v converted to the target coordinate
+// system. As the basis change is a rotation or reflection, this also holds
+// for surface normals.
+func (c BasisChange[T]) Vec3(v Vector3[T]) Vector3[T] {
+	return c.m.MulVec3(v)
+}
+
+// Quat returns the rotation q converted to the target coordinate system.
+// If the handedness changes, the sense of the rotation changes as well.
+func (c BasisChange[T]) Quat(q Quaternion[T]) Quaternion[T] {
+	v := c.m.MulVec3(Vector3[T]{q.X, q.Y, q.Z}).Mul(c.det)
+	return Quaternion[T]{v.X, v.Y, v.Z, q.W}
+}
+
+// Mat4 returns the transformation matrix m converted to the target
+// coordinate system, i.e. it transforms converted points like m transforms
+// the original points.
+func (c BasisChange[T]) Mat4(m *Matrix4[T]) Matrix4[T] {
+	var b, bt, r Matrix4[T]
+	b.FromMat3(&c.m)
+	bt.T(&b)
+	r.Mul(&b, m)
+	return *r.Mul(&r, &bt)
+}
+
+// Matrix returns the matrix that transforms points from the source to the
+// target coordinate system.
+func (c BasisChange[T]) Matrix() Matrix4[T] {
+	var m Matrix4[T]
+	return *m.FromMat3(&c.m)
+}
+
+// Inv returns the change of basis from the target to the source
+// coordinate system.
+func (c BasisChange[T]) Inv() BasisChange[T] {
+	c.m.T(&c.m)
+	return c
+}
+
+// FlipsWinding reports whether the basis change switches the handedness,
+// so that the winding order of triangles must be reversed to keep their
+// front faces.
+func (c BasisChange[T]) FlipsWinding() bool {
+	return c.det < 0
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import "testing"
+
+func TestCoordSystemHandedness(t *testing.T) {
+	tests := []struct {
+		c    CoordSystem
+		want Handedness
+	}{
+		{YUpRightHanded, RightHanded},
+		{YUpLeftHanded, LeftHanded},
+		{ZUpRightHanded, RightHanded},
+		{ZUpLeftHanded, LeftHanded},
+		{CoordSystem{Right: NegX, Up: PosY, Forward: PosZ}, RightHanded},
+		{CoordSystem{Right: PosZ, Up: NegX, Forward: NegY}, LeftHanded},
+	}
+	for _, tt := range tests {
+		if !tt.c.Valid() {
+			t.Errorf("%v.Valid() = false, want true", tt.c)
+		}
+		if h := tt.c.Handedness(); h != tt.want {
+			t.Errorf("%v.Handedness() = %v, want %v", tt.c, h, tt.want)
+		}
+	}
+	for _, c := range []CoordSystem{
+		{Right: PosX, Up: NegX, Forward: PosZ},
+		{Right: PosX, Up: PosY, Forward: NegZ + 1},
+	} {
+		if c.Valid() {
+			t.Errorf("%v.Valid() = true, want false", c)
+		}
+	}
+	if s := NegZ.String(); s != "-Z" {
+		t.Errorf("NegZ.String() = %q, want %q", s, "-Z")
+	}
+}
+
+func TestBasisChangeVec3(t *testing.T) {
+	tests := []struct {
+		from, to CoordSystem
+		v, want  Vec3
+		flips    bool
+	}{
+		// Blender to glTF, like the Y up option of Blender's exporter.
+		{ZUpRightHanded, YUpRightHanded, V3(1, 2, 3), V3(1, 3, -2), false},
+		{YUpRightHanded, ZUpRightHanded, V3(1, 3, -2), V3(1, 2, 3), false},
+		// OpenGL to Direct3D
+		{YUpRightHanded, YUpLeftHanded, V3(1, 2, 3), V3(1, 2, -3), true},
+		// Unreal Engine to Unity
+		{ZUpLeftHanded, YUpLeftHanded, V3(1, 2, 3), V3(2, 3, 1), false},
+		// Unreal Engine to glTF
+		{ZUpLeftHanded, YUpRightHanded, V3(1, 2, 3), V3(2, 3, -1), true},
+		{YUpRightHanded, YUpRightHanded, V3(1, 2, 3), V3(1, 2, 3), false},
+	}
+	for _, tt := range tests {
+		c := NewBasisChange[float32](tt.from, tt.to)
+		if x := c.Vec3(tt.v); x != tt.want {
+			t.Errorf("NewBasisChange(%v, %v).Vec3(%s) = %s, want %s", tt.from, tt.to, tt.v, x, tt.want)
+		}
+		if x := c.Inv().Vec3(tt.want); x != tt.v {
+			t.Errorf("NewBasisChange(%v, %v).Inv().Vec3(%s) = %s, want %s", tt.from, tt.to, tt.want, x, tt.v)
+		}
+		m := c.Matrix()
+		if x := tt.v.Transform(&m); x != tt.want {
+			t.Errorf("%s.Transform(NewBasisChange(%v, %v).Matrix()) = %s, want %s", tt.v, tt.from, tt.to, x, tt.want)
+		}
+		if f := c.FlipsWinding(); f != tt.flips {
+			t.Errorf("NewBasisChange(%v, %v).FlipsWinding() = %t, want %t", tt.from, tt.to, f, tt.flips)
+		}
+	}
+}
+
+func TestBasisChangeQuatMat4(t *testing.T) {
+	q := QuatRot(Rad(50), V3(1, -2, 0.5).Norm())
+	var m Mat4
+	m.Translate(&id, V3(3, -1, 2))
+	m.RotQuat(&m, q)
+	m.Scale(&m, V3(2, 1, 0.5))
+	points := []Vec3{V3(0, 0, 0), V3(1, 2, 3), V3(-4, 0.5, 1)}
+	systems := []CoordSystem{YUpRightHanded, YUpLeftHanded, ZUpRightHanded, ZUpLeftHanded}
+	for _, from := range systems {
+		for _, to := range systems {
+			c := NewBasisChange[float32](from, to)
+			cq := c.Quat(q)
+			cm := c.Mat4(&m)
+			for _, p := range points {
+				want := c.Vec3(q.Rotate(p))
+				if x := cq.Rotate(c.Vec3(p)); !x.NearEq(want) {
+					t.Errorf("%v to %v: converted rotation of %s = %s, want %s", from, to, p, x, want)
+				}
+				want = c.Vec3(p.Transform(&m))
+				if x := c.Vec3(p).Transform(&cm); !x.NearEq(want) {
+					t.Errorf("%v to %v: converted transformation of %s = %s, want %s", from, to, p, x, want)
+				}
+			}
+		}
+	}
+}
+
+func TestNewBasisChangePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewBasisChange with invalid coordinate system did not panic")
+		}
+	}()
+	NewBasisChange[float64](CoordSystem{}, YUpRightHanded)
+}
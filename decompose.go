@@ -0,0 +1,131 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+// A Decomposition holds the components of a 4x4 transformation matrix as
+// returned by Matrix4.Decompose. The matrix is the product P*T*R*H*S of the
+// perspective P, the translation T, the rotation R, the shear H and the
+// scale S, i.e. the scale is applied first.
+type Decomposition[T Float] struct {
+	Translation Vector3[T]
+	Rotation    Quaternion[T]
+	Scale       Vector3[T]
+
+	// Shear holds the shear factors XY, XZ and YZ in X, Y and Z, i.e. the
+	// amount of Y added to X, of Z added to X and of Z added to Y. It is
+	// zero for matrices without shear.
+	Shear Vector3[T]
+
+	// Perspective is the last row of P. It is zero for affine
+	// transformations, for which P is the identity matrix.
+	Perspective Vector4[T]
+}
+
+// Decompose decomposes m into its translation, rotation, scale, shear and
+// perspective components. A matrix built with Translate, Rot, RotQuat and
+// Scale, in this order, has no shear and perspective, and the components
+// are the arguments of these operations. If the transformation includes a
+// reflection, the X scale factor is negative.
+//
+// Decompose reports whether it succeeded. It fails for degenerate
+// matrices with a zero last row or with columns of the upper-left 3x3 part
+// that are zero or linearly dependent up to the rounding errors of T, as
+// the components of such a matrix are dominated by rounding errors. Widely
+// different scale factors are no problem.
+func (m *Matrix4[T]) Decompose() (d Decomposition[T], ok bool) {
+	row := Vector4[T]{m[0][3], m[1][3], m[2][3], m[3][3]}
+	if row == (Vector4[T]{}) {
+		return d, false
+	}
+	// m = P*A with the affine transformation A.
+	a := *m
+	a[0][3], a[1][3], a[2][3], a[3][3] = 0, 0, 0, 1
+	d.Translation = Vector3[T]{a[3][0], a[3][1], a[3][2]}
+
+	// Gram-Schmidt orthonormalization of the columns of the upper-left
+	// 3x3 part, which is R*H*S.
+	c0 := Vector3[T]{a[0][0], a[0][1], a[0][2]}
+	c1 := Vector3[T]{a[1][0], a[1][1], a[1][2]}
+	c2 := Vector3[T]{a[2][0], a[2][1], a[2][2]}
+	// A column is linearly dependent on the previous ones up to rounding
+	// errors if only a tiny fraction of its length remains after
+	// subtracting its projections onto them.
+	tol := 16 * machineEpsilon[T]()
+	sx := c0.Len()
+	if !(sx > 0) {
+		return d, false
+	}
+	c0 = c0.Div(sx)
+	xy := c0.Dot(c1)
+	l1 := c1.Len()
+	c1 = c1.Sub(c0.Mul(xy))
+	sy := c1.Len()
+	if !(sy > tol*l1) {
+		return d, false
+	}
+	c1 = c1.Div(sy)
+	xz := c0.Dot(c2)
+	l2 := c2.Len()
+	c2 = c2.Sub(c0.Mul(xz))
+	yz := c1.Dot(c2)
+	c2 = c2.Sub(c1.Mul(yz))
+	sz := c2.Len()
+	if !(sz > tol*l2) {
+		return d, false
+	}
+	c2 = c2.Div(sz)
+	if c0.Dot(c1.Cross(c2)) < 0 {
+		// Turn the reflection into a rotation by mirroring X.
+		sx, c0, xy, xz = -sx, c0.Neg(), -xy, -xz
+	}
+	d.Scale = Vector3[T]{sx, sy, sz}
+	d.Shear = Vector3[T]{xy / sy, xz / sz, yz / sz}
+	r := Matrix4[T]{
+		{c0.X, c0.Y, c0.Z, 0},
+		{c1.X, c1.Y, c1.Z, 0},
+		{c2.X, c2.Y, c2.Z, 0},
+		{0, 0, 0, 1},
+	}
+	d.Rotation = QuatFromMat4(&r)
+
+	if row != (Vector4[T]{0, 0, 0, 1}) {
+		// The last row of P*A is the last row of P times A.
+		var inv Matrix4[T]
+		inv.InvAffine(&a)
+		var p [4]T
+		for j := range 4 {
+			p[j] = row.X*inv[j][0] + row.Y*inv[j][1] + row.Z*inv[j][2] + row.W*inv[j][3]
+		}
+		d.Perspective = Vector4[T]{p[0], p[1], p[2], p[3]}
+	}
+	return d, true
+}
+
+// Compose sets m to the transformation matrix with the components d, which
+// is the inverse of Decompose, and returns m. The rotation must be a unit
+// quaternion.
+func (m *Matrix4[T]) Compose(d Decomposition[T]) *Matrix4[T] {
+	var r Matrix4[T]
+	r.ID().RotQuat(&r, d.Rotation)
+	r0 := Vector3[T]{r[0][0], r[0][1], r[0][2]}
+	r1 := Vector3[T]{r[1][0], r[1][1], r[1][2]}
+	r2 := Vector3[T]{r[2][0], r[2][1], r[2][2]}
+	s, h, t := d.Scale, d.Shear, d.Translation
+	c0 := r0.Mul(s.X)
+	c1 := r0.Mul(h.X).Add(r1).Mul(s.Y)
+	c2 := r0.Mul(h.Y).Add(r1.Mul(h.Z)).Add(r2).Mul(s.Z)
+	*m = Matrix4[T]{
+		{c0.X, c0.Y, c0.Z, 0},
+		{c1.X, c1.Y, c1.Z, 0},
+		{c2.X, c2.Y, c2.Z, 0},
+		{t.X, t.Y, t.Z, 1},
+	}
+	if p := d.Perspective; p != (Vector4[T]{}) {
+		for j := range 4 {
+			m[j][3] = p.X*m[j][0] + p.Y*m[j][1] + p.Z*m[j][2] + p.W*m[j][3]
+		}
+	}
+	return m
+}
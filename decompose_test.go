@@ -0,0 +1,170 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMat4Decompose(t *testing.T) {
+	tests := []struct {
+		t Vec3
+		r Quat
+		s Vec3
+	}{
+		{V3(0, 0, 0), QuatID, V3(1, 1, 1)},
+		{V3(3, -1, 2), QuatRot(Rad(50), V3(1, -2, 0.5).Norm()), V3(2, 1, 0.5)},
+		{V3(-7, 0, 0.25), QuatRot(Rad(180), V3(0, 1, 0)), V3(0.001, 100, 3)},
+		{V3(1, 2, 3), QuatRot(Rad(-30), V3(0, 0, 1)), V3(-2, 1, 1)},
+	}
+	for _, tt := range tests {
+		var m Mat4
+		m.Translate(&id, tt.t)
+		m.RotQuat(&m, tt.r)
+		m.Scale(&m, tt.s)
+		d, ok := m.Decompose()
+		if !ok {
+			t.Errorf("%v.Decompose() failed", m)
+			continue
+		}
+		if !d.Translation.NearEq(tt.t) || !d.Scale.NearEq(tt.s) ||
+			!(d.Rotation.NearEq(tt.r) || d.Rotation.NearEq(tt.r.Mul(Quat{0, 0, 0, -1}))) {
+			t.Errorf("%v.Decompose() = %+v, want translation %s, rotation %s, scale %s",
+				m, d, tt.t, tt.r, tt.s)
+		}
+		if d.Shear != (Vec3{}) && !d.Shear.NearEq(Vec3{}) || d.Perspective != (Vec4{}) {
+			t.Errorf("%v.Decompose() has shear %s and perspective %s, want zero", m, d.Shear, d.Perspective)
+		}
+		var c Mat4
+		if c.Compose(d); !c.NearEq(&m) {
+			t.Errorf("Compose(%+v) = %v, want %v", d, c, m)
+		}
+	}
+}
+
+func TestMat4DecomposeWideScale(t *testing.T) {
+	r := QuatRot(Rad(30), V3(1, 1, 0).Norm())
+	s := V3(1000, 1, 0.001)
+	var m Mat4
+	m.RotQuat(&id, r)
+	m.Scale(&m, s)
+	if d, ok := m.Decompose(); !ok || !d.Scale.NearEq(s) {
+		t.Errorf("%v.Decompose() = %+v, %t, want scale %s, true", m, d, ok, s)
+	}
+
+	rd := QuatRot(math.Pi/6, V3d(1, 1, 0).Norm())
+	sd := V3d(1e6, 1, 1e-6)
+	var md Mat4d
+	md.ID().RotQuat(&md, rd)
+	md.Scale(&md, sd)
+	d, ok := md.Decompose()
+	if !ok || !NearEqRel(d.Scale.X, sd.X, 1e-12) || !NearEqRel(d.Scale.Y, sd.Y, 1e-12) ||
+		!NearEqRel(d.Scale.Z, sd.Z, 1e-12) {
+		t.Errorf("%v.Decompose() = %+v, %t, want scale %s, true", md, d, ok, sd)
+	}
+	var c Mat4d
+	if c.Compose(d); !c.NearEq(&md) {
+		t.Errorf("Compose(%+v) = %v, want %v", d, c, md)
+	}
+}
+
+func TestMat4DecomposeReflection(t *testing.T) {
+	// A reflection in Y is reported as a reflection in X followed by a
+	// rotation, which is the same transformation.
+	var m Mat4
+	m.Translate(&id, V3(1, 2, 3))
+	m.Scale(&m, V3(1, -2, 1))
+	d, ok := m.Decompose()
+	if !ok {
+		t.Fatalf("%v.Decompose() failed", m)
+	}
+	if !d.Scale.NearEq(V3(-1, 2, 1)) {
+		t.Errorf("%v.Decompose() has scale %s, want (-1, 2, 1)", m, d.Scale)
+	}
+	var c Mat4
+	if c.Compose(d); !c.NearEq(&m) {
+		t.Errorf("Compose(%+v) = %v, want %v", d, c, m)
+	}
+}
+
+func TestMat4DecomposeShearPerspective(t *testing.T) {
+	d := Decomposition[float32]{
+		Translation: V3(1, -2, 3),
+		Rotation:    QuatRot(Rad(70), V3(1, 1, 0).Norm()),
+		Scale:       V3(2, 3, 0.5),
+		Shear:       V3(0.5, -0.25, 1.5),
+	}
+	var m Mat4
+	m.Compose(d)
+	// The shear adds 0.5*Y to X after scaling and before rotating and
+	// translating.
+	v := V3(0, 1, 0)
+	want := d.Rotation.Rotate(V3(0.5*3, 3, 0)).Add(d.Translation)
+	if x := v.Transform(&m); !x.NearEq(want) {
+		t.Errorf("%s.Transform(Compose(%+v)) = %s, want %s", v, d, x, want)
+	}
+	got, ok := m.Decompose()
+	if !ok || !got.Shear.NearEq(d.Shear) || !got.Scale.NearEq(d.Scale) || !got.Translation.NearEq(d.Translation) {
+		t.Errorf("%v.Decompose() = %+v, %t, want %+v", m, got, ok, d)
+	}
+
+	d.Perspective = V4(0.1, 0, -0.2, 1)
+	m.Compose(d)
+	if m[0][3] == 0 && m[1][3] == 0 && m[2][3] == 0 {
+		t.Fatalf("Compose(%+v) = %v, want non-affine matrix", d, m)
+	}
+	got, ok = m.Decompose()
+	if !ok || !got.Perspective.NearEq(d.Perspective) || !got.Shear.NearEq(d.Shear) {
+		t.Errorf("%v.Decompose() = %+v, %t, want %+v", m, got, ok, d)
+	}
+	var c Mat4
+	if c.Compose(got); !c.NearEq(&m) {
+		t.Errorf("Compose(%+v) = %v, want %v", got, c, m)
+	}
+
+	// Projection matrices have a perspective component.
+	var p Mat4
+	p.Perspective(Rad(60), 1.5, 0.1, 100)
+	got, ok = p.Decompose()
+	if !ok || got.Perspective == (Vec4{}) {
+		t.Errorf("%v.Decompose() = %+v, %t, want perspective component", p, got, ok)
+	}
+	if c.Compose(got); !c.NearEq(&p) {
+		t.Errorf("Compose(%+v) = %v, want %v", got, c, p)
+	}
+}
+
+// planarProjection is the orthogonal projection I - n*nᵀ onto the plane
+// with the normal n = (1, 1, 1)/√3. It is singular, but its determinant is
+// not exactly zero due to rounding errors.
+var planarProjection = func() Mat4 {
+	n := Vec3{1, 1, 1}.Norm()
+	nv := [3]float32{n.X, n.Y, n.Z}
+	m := Mat4{3: {3: 1}}
+	for j := range 3 {
+		for i := range 3 {
+			m[j][i] = -nv[i] * nv[j]
+		}
+		m[j][j]++
+	}
+	return m
+}()
+
+func TestMat4DecomposeDegenerate(t *testing.T) {
+	tests := []Mat4{
+		zero,
+		{{1, 0, 0, 0}, {0, 0, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1}},
+		{{1, 0, 0, 0}, {2, 0, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1}},
+		{{1, 0, 0, 0}, {0, 1, 0, 0}, {1, 1, 0, 0}, {5, 5, 5, 1}},
+		{{1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}, {5, 5, 5, 0}},
+		planarProjection,
+	}
+	for _, m := range tests {
+		if d, ok := m.Decompose(); ok {
+			t.Errorf("%v.Decompose() = %+v, true, want false", m, d)
+		}
+	}
+}
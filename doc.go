@@ -33,5 +33,73 @@ Using matrices:
 
 	// Multiply a and b, store the result in a.
 	a.Mul(&a, &b)
+
+The matrices transform column vectors like in OpenGL: v.Transform(m)
+computes m*v, a.Mul(a, b) applies b first and then a, and the first index
+selects a column for Mat3, Mat4 and Affine2 alike, i.e. m[3] holds the
+translation of a Mat4 and m[2] the translation of an Affine2. The elements
+are stored in column-major order, which is also the row-major order of the
+transposed matrix for row vectors as in DirectXMath, so the matrices can
+be uploaded without transposing to all of these APIs:
+
+	API           vectors  storage       clip space Y  depth    conversion
+	OpenGL        column   column-major  up            -1 to 1  -
+	Vulkan        column   column-major  down          0 to 1   VulkanClip
+	Direct3D      column*  column-major  up            0 to 1   D3DClip
+	DirectXMath   row      row-major     up            0 to 1   D3DClip
+	Metal         column   column-major  up            0 to 1   D3DClip
+
+	* HLSL with the default column_major packing and mul(m, v)
+
+An Affine2 corresponds to the first two rows of a Mat3 for 2-dimensional
+homogeneous coordinates and is converted to a Mat4 with FromAffine2, which
+puts its columns m[0], m[1] and m[2] into the columns m[0], m[1] and m[3]
+of the Mat4.
+
+Use ColumnMajor and RowMajor to export the elements explicitly and
+FromColumnMajor and FromRowMajor to import them. The projection matrices
+like Perspective map to the clip space of OpenGL and can be converted with
+D3DClip and VulkanClip. For other depth ranges, including reverse-Z, and
+left-handed view spaces create them directly with PerspectiveClip,
+FrustumClip and OrthoClip to avoid the loss of precision.
+
+Data from tools with other coordinate system conventions, e.g. Z up, can
+be converted with a BasisChange:
+
+	c := geom.NewBasisChange[float32](geom.ZUpRightHanded, geom.YUpRightHanded)
+	p := c.Vec3(blenderPos)
+	q := c.Quat(blenderRot)
+
+Vec2, Vec3, Vec4, Mat3, Mat4, Affine2 and Quat are aliases for the
+generic types Vector2, Vector3, Vector4, Matrix3, Matrix4, AffineTransform2
+and Quaternion instantiated with float32, the precision used by graphics
+APIs. The float64 variants Vec2d, Vec3d, Vec4d, Mat3d, Mat4d, Affine2d and
+Quatd are useful for computations that need more precision, e.g. physics or
+CAD:
+
+	p := geom.V3d(1e6, 0.25, -3)
+	q := p.Add(geom.V3d(1e-3, 0, 0))
+
+	// Convert to float32 for uploading to the GPU
+	v := q.Float32()
+
+The vector, matrix, quaternion, rectangle and box types implement
+encoding.TextMarshaler using the format of their String methods, e.g.
+"(3.25, -1.5)" for a Vec2 or "(0, 0)-(4, 3)" for a Rectangle,
+json.Marshaler as arrays of numbers, e.g. [3.25,-1.5] or [[0,0],[4,3]],
+and encoding.BinaryMarshaler as the little-endian IEEE 754
+representations of the components, as well as the corresponding
+unmarshaler interfaces.
+
+Vec2, Vec3, Mat4 and Rectangle implement fmt.Formatter, so that the
+precision of their components can be controlled and the output aligned:
+
+	fmt.Printf("%.3f\n", v)  // (2.000, 1.500, 0.500)
+	fmt.Printf("%+v\n", v)   // (X: 2, Y: 1.5, Z: 0.5)
+	fmt.Printf("%+.2f\n", m) // multi-line with aligned columns
+
+Values of these types and structs and arrays of them can be encoded for
+GLSL uniform and shader storage blocks with Std140.Append and
+Std430.Append.
 */
 package geom // import "github.com/fzipp/geom"
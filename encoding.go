@@ -0,0 +1,453 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"unsafe"
+)
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Vector2[T]) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It accepts the formats described at ParseVec2.
+func (v *Vector2[T]) UnmarshalText(text []byte) error {
+	var a [2]T
+	if err := parseVector(string(text), a[:]); err != nil {
+		return err
+	}
+	*v = Vector2[T]{a[0], a[1]}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v Vector2[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]T{v.X, v.Y})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *Vector2[T]) UnmarshalJSON(data []byte) error {
+	var a [2]T
+	if ok, err := unmarshalJSON(data, a[:], 2); !ok {
+		return err
+	}
+	*v = Vector2[T]{a[0], a[1]}
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (v Vector2[T]) MarshalBinary() ([]byte, error) {
+	return appendBinary(nil, v.X, v.Y), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (v *Vector2[T]) UnmarshalBinary(data []byte) error {
+	var a [2]T
+	if err := readBinary(data, a[:]); err != nil {
+		return err
+	}
+	*v = Vector2[T]{a[0], a[1]}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Vector3[T]) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It accepts the formats described at ParseVec3.
+func (v *Vector3[T]) UnmarshalText(text []byte) error {
+	var a [3]T
+	if err := parseVector(string(text), a[:]); err != nil {
+		return err
+	}
+	*v = Vector3[T]{a[0], a[1], a[2]}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v Vector3[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3]T{v.X, v.Y, v.Z})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *Vector3[T]) UnmarshalJSON(data []byte) error {
+	var a [3]T
+	if ok, err := unmarshalJSON(data, a[:], 3); !ok {
+		return err
+	}
+	*v = Vector3[T]{a[0], a[1], a[2]}
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (v Vector3[T]) MarshalBinary() ([]byte, error) {
+	return appendBinary(nil, v.X, v.Y, v.Z), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (v *Vector3[T]) UnmarshalBinary(data []byte) error {
+	var a [3]T
+	if err := readBinary(data, a[:]); err != nil {
+		return err
+	}
+	*v = Vector3[T]{a[0], a[1], a[2]}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Vector4[T]) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *Vector4[T]) UnmarshalText(text []byte) error {
+	var a [4]T
+	if err := parseVector(string(text), a[:]); err != nil {
+		return err
+	}
+	*v = Vector4[T]{a[0], a[1], a[2], a[3]}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v Vector4[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([4]T{v.X, v.Y, v.Z, v.W})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *Vector4[T]) UnmarshalJSON(data []byte) error {
+	var a [4]T
+	if ok, err := unmarshalJSON(data, a[:], 4); !ok {
+		return err
+	}
+	*v = Vector4[T]{a[0], a[1], a[2], a[3]}
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (v Vector4[T]) MarshalBinary() ([]byte, error) {
+	return appendBinary(nil, v.X, v.Y, v.Z, v.W), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (v *Vector4[T]) UnmarshalBinary(data []byte) error {
+	var a [4]T
+	if err := readBinary(data, a[:]); err != nil {
+		return err
+	}
+	*v = Vector4[T]{a[0], a[1], a[2], a[3]}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (q Quaternion[T]) MarshalText() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (q *Quaternion[T]) UnmarshalText(text []byte) error {
+	var a [4]T
+	if err := parseVector(string(text), a[:]); err != nil {
+		return err
+	}
+	*q = Quaternion[T]{a[0], a[1], a[2], a[3]}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (q Quaternion[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([4]T{q.X, q.Y, q.Z, q.W})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (q *Quaternion[T]) UnmarshalJSON(data []byte) error {
+	var a [4]T
+	if ok, err := unmarshalJSON(data, a[:], 4); !ok {
+		return err
+	}
+	*q = Quaternion[T]{a[0], a[1], a[2], a[3]}
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (q Quaternion[T]) MarshalBinary() ([]byte, error) {
+	return appendBinary(nil, q.X, q.Y, q.Z, q.W), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (q *Quaternion[T]) UnmarshalBinary(data []byte) error {
+	var a [4]T
+	if err := readBinary(data, a[:]); err != nil {
+		return err
+	}
+	*q = Quaternion[T]{a[0], a[1], a[2], a[3]}
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m Matrix3[T]) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (m *Matrix3[T]) UnmarshalText(text []byte) error {
+	var a Matrix3[T]
+	if err := parseMatrix(string(text), a.Floats()[:], 3); err != nil {
+		return err
+	}
+	*m = a
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (m Matrix3[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([3][3]T(m))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *Matrix3[T]) UnmarshalJSON(data []byte) error {
+	var a Matrix3[T]
+	if ok, err := unmarshalJSON(data, a.Floats()[:], 3, 3); !ok {
+		return err
+	}
+	*m = a
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (m Matrix3[T]) MarshalBinary() ([]byte, error) {
+	return appendBinary(nil, m.Floats()[:]...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (m *Matrix3[T]) UnmarshalBinary(data []byte) error {
+	var a Matrix3[T]
+	if err := readBinary(data, a.Floats()[:]); err != nil {
+		return err
+	}
+	*m = a
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (m Matrix4[T]) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It accepts the formats described at ParseMat4.
+func (m *Matrix4[T]) UnmarshalText(text []byte) error {
+	var a Matrix4[T]
+	if err := parseMatrix(string(text), a.Floats()[:], 4); err != nil {
+		return err
+	}
+	*m = a
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (m Matrix4[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([4][4]T(m))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *Matrix4[T]) UnmarshalJSON(data []byte) error {
+	var a Matrix4[T]
+	if ok, err := unmarshalJSON(data, a.Floats()[:], 4, 4); !ok {
+		return err
+	}
+	*m = a
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (m Matrix4[T]) MarshalBinary() ([]byte, error) {
+	return appendBinary(nil, m.Floats()[:]...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (m *Matrix4[T]) UnmarshalBinary(data []byte) error {
+	var a Matrix4[T]
+	if err := readBinary(data, a.Floats()[:]); err != nil {
+		return err
+	}
+	*m = a
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (r Rectangle) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It accepts the formats described at ParseRect.
+func (r *Rectangle) UnmarshalText(text []byte) error {
+	var a [4]float32
+	if err := parseRange(string(text), a[:]); err != nil {
+		return err
+	}
+	*r = Rect(a[0], a[1], a[2], a[3])
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r Rectangle) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2][2]float32{{r.Min.X, r.Min.Y}, {r.Max.X, r.Max.Y}})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *Rectangle) UnmarshalJSON(data []byte) error {
+	var a [4]float32
+	if ok, err := unmarshalJSON(data, a[:], 2, 2); !ok {
+		return err
+	}
+	*r = Rect(a[0], a[1], a[2], a[3])
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (r Rectangle) MarshalBinary() ([]byte, error) {
+	return appendBinary(nil, r.Min.X, r.Min.Y, r.Max.X, r.Max.Y), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (r *Rectangle) UnmarshalBinary(data []byte) error {
+	var a [4]float32
+	if err := readBinary(data, a[:]); err != nil {
+		return err
+	}
+	*r = Rect(a[0], a[1], a[2], a[3])
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (b Box) MarshalText() ([]byte, error) {
+	return []byte(b.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (b *Box) UnmarshalText(text []byte) error {
+	var a [6]float32
+	if err := parseRange(string(text), a[:]); err != nil {
+		return err
+	}
+	*b = Box{Vec3{a[0], a[1], a[2]}, Vec3{a[3], a[4], a[5]}}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (b Box) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2][3]float32{
+		{b.Min.X, b.Min.Y, b.Min.Z},
+		{b.Max.X, b.Max.Y, b.Max.Z},
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (b *Box) UnmarshalJSON(data []byte) error {
+	var a [6]float32
+	if ok, err := unmarshalJSON(data, a[:], 2, 3); !ok {
+		return err
+	}
+	*b = Box{Vec3{a[0], a[1], a[2]}, Vec3{a[3], a[4], a[5]}}
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (b Box) MarshalBinary() ([]byte, error) {
+	return appendBinary(nil,
+		b.Min.X, b.Min.Y, b.Min.Z,
+		b.Max.X, b.Max.Y, b.Max.Z), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (b *Box) UnmarshalBinary(data []byte) error {
+	var a [6]float32
+	if err := readBinary(data, a[:]); err != nil {
+		return err
+	}
+	*b = Box{Vec3{a[0], a[1], a[2]}, Vec3{a[3], a[4], a[5]}}
+	return nil
+}
+
+// unmarshalJSON decodes a JSON array of numbers of the given shape into
+// dst. A shape with one dimension n denotes an array of n numbers, a shape
+// with two dimensions m, n denotes an array of m arrays of n numbers,
+// which are stored consecutively in dst. It reports false if dst was not
+// set, either because of an error or because data was the JSON null
+// value, which is a no-op by convention.
+func unmarshalJSON[T Float](data []byte, dst []T, shape ...int) (bool, error) {
+	if string(data) == "null" {
+		return false, nil
+	}
+	if len(shape) == 2 {
+		var a [][]T
+		if err := json.Unmarshal(data, &a); err != nil {
+			return false, err
+		}
+		if len(a) != shape[0] {
+			return false, fmt.Errorf("geom: JSON array has %d elements, want %d",
+				len(a), shape[0])
+		}
+		for i, row := range a {
+			if len(row) != shape[1] {
+				return false, fmt.Errorf("geom: JSON array %d has %d elements, want %d",
+					i, len(row), shape[1])
+			}
+			copy(dst[i*shape[1]:], row)
+		}
+		return true, nil
+	}
+	var a []T
+	if err := json.Unmarshal(data, &a); err != nil {
+		return false, err
+	}
+	if len(a) != shape[0] {
+		return false, fmt.Errorf("geom: JSON array has %d elements, want %d",
+			len(a), shape[0])
+	}
+	copy(dst, a)
+	return true, nil
+}
+
+// appendBinary appends the little-endian IEEE 754 representations of fs
+// to b.
+func appendBinary[T Float](b []byte, fs ...T) []byte {
+	for _, f := range fs {
+		if unsafe.Sizeof(f) == 4 {
+			b = binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(f)))
+		} else {
+			b = binary.LittleEndian.AppendUint64(b, math.Float64bits(float64(f)))
+		}
+	}
+	return b
+}
+
+// readBinary decodes the little-endian IEEE 754 representations of
+// len(dst) numbers from data into dst. The length of data must match.
+func readBinary[T Float](data []byte, dst []T) error {
+	size := int(unsafe.Sizeof(dst[0]))
+	if len(data) != len(dst)*size {
+		return fmt.Errorf("geom: binary data has %d bytes, want %d",
+			len(data), len(dst)*size)
+	}
+	for i := range dst {
+		if size == 4 {
+			dst[i] = T(math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:])))
+		} else {
+			dst[i] = T(math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:])))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestMarshalText(t *testing.T) {
+	m := Mat4{
+		{1, 0, 0, 0},
+		{0, 2.5, 0, 0},
+		{0, 0, 1, 0},
+		{-3, 4, 5, 1},
+	}
+	tests := []struct {
+		v    encoding.TextMarshaler
+		want string
+	}{
+		{V2(3.25, -1.5), "(3.25, -1.5)"},
+		{V3(1, 2, 3), "(1, 2, 3)"},
+		{V4(1, 2, 3, 4), "(1, 2, 3, 4)"},
+		{V3d(0.1, 0, -1e100), "(0.1, 0, -1e+100)"},
+		{Quat{0, 0, 0, 1}, "(0, 0, 0, 1)"},
+		{Mat3{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, "((1, 2, 3), (4, 5, 6), (7, 8, 9))"},
+		{m, "((1, 0, 0, 0), (0, 2.5, 0, 0), (0, 0, 1, 0), (-3, 4, 5, 1))"},
+		{Rect(0, -1, 4, 3.5), "(0, -1)-(4, 3.5)"},
+		{Box{V3(0, 0, 0), V3(1, 2, 3)}, "(0, 0, 0)-(1, 2, 3)"},
+	}
+	for _, tt := range tests {
+		b, err := tt.v.MarshalText()
+		if err != nil || string(b) != tt.want {
+			t.Errorf("%#v.MarshalText() = %q, %v, want %q, nil", tt.v, b, err, tt.want)
+		}
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	tests := []struct {
+		text string
+		v    encoding.TextUnmarshaler
+		want any
+	}{
+		{"(3.25, -1.5)", new(Vec2), V2(3.25, -1.5)},
+		{" ( 3.25 ,-1.5 ) ", new(Vec2), V2(3.25, -1.5)},
+		{"(1,2,3)", new(Vec3), V3(1, 2, 3)},
+		{"(1, 2, 3, 4)", new(Vec4), V4(1, 2, 3, 4)},
+		{"(0.1, 0, -1e+100)", new(Vec3d), V3d(0.1, 0, -1e100)},
+		{"(0, 0, 0, 1)", new(Quat), Quat{0, 0, 0, 1}},
+		{"((1, 2, 3), (4, 5, 6), (7, 8, 9))", new(Mat3), Mat3{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}},
+		{
+			"((1, 0, 0, 0), (0, 2.5, 0, 0), (0, 0, 1, 0), (-3, 4, 5, 1))", new(Mat4),
+			Mat4{{1, 0, 0, 0}, {0, 2.5, 0, 0}, {0, 0, 1, 0}, {-3, 4, 5, 1}},
+		},
+		{"(0, -1)-(4, 3.5)", new(Rectangle), Rect(0, -1, 4, 3.5)},
+		{"(1, 2)-(-3, -4)", new(Rectangle), Rect(1, 2, -3, -4)},
+		{"(0, 0, 0) - (1, 2, 3)", new(Box), Box{V3(0, 0, 0), V3(1, 2, 3)}},
+	}
+	for _, tt := range tests {
+		if err := tt.v.UnmarshalText([]byte(tt.text)); err != nil {
+			t.Errorf("UnmarshalText(%q) into %T: unexpected error: %v", tt.text, tt.v, err)
+			continue
+		}
+		if x := deref(tt.v); x != tt.want {
+			t.Errorf("UnmarshalText(%q) into %T = %v, want %v", tt.text, tt.v, x, tt.want)
+		}
+	}
+}
+
+func TestUnmarshalTextErrors(t *testing.T) {
+	tests := []struct {
+		text string
+		v    encoding.TextUnmarshaler
+	}{
+		{"", new(Vec2)},
+		{"(1, 2", new(Vec2)},
+		{"(1, 2, 3)", new(Vec2)},
+		{"(1)", new(Vec2)},
+		{"(1, x)", new(Vec2)},
+		{"(1, 2) x", new(Vec2)},
+		{"(1, 1e39)", new(Vec2)},
+		{"((1, 2, 3), (4, 5, 6))", new(Mat3)},
+		{"(1, 2)(3, 4)", new(Rectangle)},
+		{"(1, 2)-", new(Rectangle)},
+	}
+	for _, tt := range tests {
+		if err := tt.v.UnmarshalText([]byte(tt.text)); err == nil {
+			t.Errorf("UnmarshalText(%q) into %T: expected error, got %v", tt.text, tt.v, deref(tt.v))
+		}
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	type scene struct {
+		Pos    Vec3      `json:"pos"`
+		Rot    Quat      `json:"rot"`
+		Bounds Rectangle `json:"bounds"`
+		Box    Box       `json:"box"`
+		M      Mat4      `json:"m"`
+	}
+	s := scene{
+		Pos:    V3(1.5, -2, 0),
+		Rot:    QuatID,
+		Bounds: Rect(0, 0, 640, 480),
+		Box:    Box{V3(-1, -1, -1), V3(1, 1, 1)},
+		M:      id,
+	}
+	want := `{"pos":[1.5,-2,0],"rot":[0,0,0,1],"bounds":[[0,0],[640,480]],` +
+		`"box":[[-1,-1,-1],[1,1,1]],"m":[[1,0,0,0],[0,1,0,0],[0,0,1,0],[0,0,0,1]]}`
+	b, err := json.Marshal(s)
+	if err != nil || string(b) != want {
+		t.Fatalf("json.Marshal(%v) = %s, %v, want %s", s, b, err, want)
+	}
+	var x scene
+	if err := json.Unmarshal(b, &x); err != nil || x != s {
+		t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", b, x, err, s)
+	}
+
+	// null is a no-op
+	v := V2(1, 2)
+	if err := json.Unmarshal([]byte("null"), &v); err != nil || v != V2(1, 2) {
+		t.Errorf("json.Unmarshal(null) = %s, %v, want (1, 2), nil", v, err)
+	}
+	if _, err := json.Marshal(V2(float32(math.NaN()), 0)); err == nil {
+		t.Errorf("json.Marshal of NaN: expected error")
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	tests := []struct {
+		data string
+		v    any
+	}{
+		{`[1]`, new(Vec2)},
+		{`[1,2,3]`, new(Vec2)},
+		{`{"x":1,"y":2}`, new(Vec2)},
+		{`["1","2"]`, new(Vec2)},
+		{`[[1,2],[3,4]]`, new(Mat4)},
+		{`[[0,0],[1]]`, new(Rectangle)},
+		{`[0,0,1,1]`, new(Rectangle)},
+	}
+	for _, tt := range tests {
+		if err := json.Unmarshal([]byte(tt.data), tt.v); err == nil {
+			t.Errorf("json.Unmarshal(%s) into %T: expected error", tt.data, tt.v)
+		}
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	b, err := V2(1, -2).MarshalBinary()
+	want := []byte{0x00, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x00, 0xc0}
+	if err != nil || !bytes.Equal(b, want) {
+		t.Errorf("V2(1, -2).MarshalBinary() = % x, %v, want % x, nil", b, err, want)
+	}
+	b, err = V2d(1, -2).MarshalBinary()
+	want = []byte{0, 0, 0, 0, 0, 0, 0xf0, 0x3f, 0, 0, 0, 0, 0, 0, 0, 0xc0}
+	if err != nil || !bytes.Equal(b, want) {
+		t.Errorf("V2d(1, -2).MarshalBinary() = % x, %v, want % x, nil", b, err, want)
+	}
+
+	tests := []struct {
+		v    encoding.BinaryMarshaler
+		u    encoding.BinaryUnmarshaler
+		size int
+	}{
+		{V2(1, 2), new(Vec2), 8},
+		{V3(1, 2, 3), new(Vec3), 12},
+		{V4(1, 2, 3, 4), new(Vec4), 16},
+		{V3d(1, 2, 3), new(Vec3d), 24},
+		{Quat{1, 2, 3, 4}, new(Quat), 16},
+		{Mat3{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, new(Mat3), 36},
+		{*a, new(Mat4), 64},
+		{Rect(1, 2, 3, 4), new(Rectangle), 16},
+		{Box{V3(1, 2, 3), V3(4, 5, 6)}, new(Box), 24},
+	}
+	for _, tt := range tests {
+		b, err := tt.v.MarshalBinary()
+		if err != nil || len(b) != tt.size {
+			t.Errorf("%v.MarshalBinary() = % x, %v, want %d bytes", tt.v, b, err, tt.size)
+			continue
+		}
+		if err := tt.u.UnmarshalBinary(b); err != nil || deref(tt.u) != tt.v {
+			t.Errorf("UnmarshalBinary(% x) = %v, %v, want %v", b, deref(tt.u), err, tt.v)
+		}
+		if err := tt.u.UnmarshalBinary(b[1:]); err == nil {
+			t.Errorf("UnmarshalBinary(% x) into %T: expected error for short data", b[1:], tt.u)
+		}
+	}
+}
+
+// deref returns the value that the pointer p points to.
+func deref(p any) any {
+	switch p := p.(type) {
+	case *Vec2:
+		return *p
+	case *Vec3:
+		return *p
+	case *Vec4:
+		return *p
+	case *Vec3d:
+		return *p
+	case *Quat:
+		return *p
+	case *Mat3:
+		return *p
+	case *Mat4:
+		return *p
+	case *Rectangle:
+		return *p
+	case *Box:
+		return *p
+	}
+	panic("unsupported type")
+}
+
+// fuzzTextStable checks that formatting the value parsed from text and
+// parsing it again yields the same text.
+func fuzzTextStable(t *testing.T, text string, v encoding.TextUnmarshaler) {
+	if v.UnmarshalText([]byte(text)) != nil {
+		return
+	}
+	b1, err := deref(v).(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText of %q: %v", text, err)
+	}
+	if err := v.UnmarshalText(b1); err != nil {
+		t.Fatalf("UnmarshalText(%q) of formatted %q: %v", b1, text, err)
+	}
+	b2, _ := deref(v).(encoding.TextMarshaler).MarshalText()
+	if !bytes.Equal(b1, b2) {
+		t.Errorf("format of parsed %q is not stable: %q != %q", text, b1, b2)
+	}
+}
+
+func FuzzVec2UnmarshalText(f *testing.F) {
+	f.Add("(3.25, -1.5)")
+	f.Add("(NaN, +Inf)")
+	f.Add("(1e-45,0x1p-2)")
+	f.Fuzz(func(t *testing.T, text string) {
+		fuzzTextStable(t, text, new(Vec2))
+	})
+}
+
+func FuzzVec3UnmarshalText(f *testing.F) {
+	f.Add("(1, 2, 3)")
+	f.Add(" ( -0 ,1e38, 3.4028235e38 ) ")
+	f.Fuzz(func(t *testing.T, text string) {
+		fuzzTextStable(t, text, new(Vec3))
+	})
+}
+
+func FuzzMat4UnmarshalText(f *testing.F) {
+	f.Add("((1, 0, 0, 0), (0, 1, 0, 0), (0, 0, 1, 0), (0, 0, 0, 1))")
+	f.Fuzz(func(t *testing.T, text string) {
+		fuzzTextStable(t, text, new(Mat4))
+	})
+}
+
+func FuzzRectangleUnmarshalText(f *testing.F) {
+	f.Add("(0, -1)-(4, 3.5)")
+	f.Add("(1,2)-(-3,-4)")
+	f.Fuzz(func(t *testing.T, text string) {
+		fuzzTextStable(t, text, new(Rectangle))
+	})
+}
+
+func FuzzMat4UnmarshalBinary(f *testing.F) {
+	b, _ := a.MarshalBinary()
+	f.Add(b)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var m Mat4
+		if m.UnmarshalBinary(data) != nil {
+			return
+		}
+		b, err := m.MarshalBinary()
+		if err != nil || !bytes.Equal(b, data) {
+			t.Errorf("MarshalBinary of unmarshaled % x = % x, %v", data, b, err)
+		}
+	})
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Format implements the fmt.Formatter interface. The verbs %v and %s
+// produce the same output as String, while the floating-point verbs %b, %e,
+// %E, %f, %F, %g, %G, %x and %X as well as the flags, width and precision
+// are applied to each component, e.g. %.3f formats v as "(3.250, -1.500)"
+// and %8.3f pads each component to a width of 8. The plus flag with %v
+// adds the field names, like "(X: 3.25, Y: -1.5)", and %#v produces a Go
+// syntax representation.
+func (v Vector2[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "%T{X:%#v, Y:%#v}", v, v.X, v.Y)
+	case isFloatVerb(verb):
+		formatTuple(f, verb, "XY", v.X, v.Y)
+	default:
+		badVerb(f, verb, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface. It supports the same verbs
+// and flags as the Format method of Vector2, e.g. %.3f formats v as
+// "(1.000, 2.000, 3.000)" and %+v as "(X: 1, Y: 2, Z: 3)".
+func (v Vector3[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "%T{X:%#v, Y:%#v, Z:%#v}", v, v.X, v.Y, v.Z)
+	case isFloatVerb(verb):
+		formatTuple(f, verb, "XYZ", v.X, v.Y, v.Z)
+	default:
+		badVerb(f, verb, v)
+	}
+}
+
+// Format implements the fmt.Formatter interface. It supports the same verbs
+// as the Format method of Vector2, applied to each element of m. As a
+// matrix has no field names, the plus flag instead selects a multi-line
+// representation for debugging with one inner tuple per line and the
+// numbers right-aligned within their columns, or left-aligned with the
+// minus flag. For example, %+.2f formats a translation matrix as
+//
+//	(( 1.00, 0.00, 0.00, 0.00),
+//	 ( 0.00, 1.00, 0.00, 0.00),
+//	 ( 0.00, 0.00, 1.00, 0.00),
+//	 (-3.00, 4.00, 5.00, 1.00))
+//
+// The multi-line representation can be parsed with ParseMat4.
+func (m Matrix4[T]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "%T{%#v, %#v, %#v, %#v}", m, m[0], m[1], m[2], m[3])
+	case isFloatVerb(verb) && f.Flag('+'):
+		formatTable(f, verb, m.Floats()[:], 4)
+	case isFloatVerb(verb):
+		f.Write([]byte{'('})
+		for i := range 4 {
+			if i > 0 {
+				f.Write([]byte(", "))
+			}
+			formatTuple(f, verb, "", m[i][0], m[i][1], m[i][2], m[i][3])
+		}
+		f.Write([]byte{')'})
+	default:
+		badVerb(f, verb, m)
+	}
+}
+
+// Format implements the fmt.Formatter interface. It supports the same verbs
+// and flags as the Format method of Vector2, applied to the components of
+// the corners, e.g. %.1f formats r as "(0.0, -1.0)-(4.0, 3.5)" and %+v as
+// "(Min: (X: 0, Y: -1), Max: (X: 4, Y: 3.5))".
+func (r Rectangle) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "%T{Min:%#v, Max:%#v}", r, r.Min, r.Max)
+	case (verb == 'v' || verb == 's') && f.Flag('+'):
+		format := fmt.FormatString(f, verb)
+		fmt.Fprintf(f, "(Min: "+format+", Max: "+format+")", r.Min, r.Max)
+	case isFloatVerb(verb):
+		format := fmt.FormatString(f, verb)
+		fmt.Fprintf(f, format+"-"+format, r.Min, r.Max)
+	default:
+		badVerb(f, verb, r)
+	}
+}
+
+// isFloatVerb reports whether verb formats floating-point numbers, or is
+// %v or %s, which format them like String.
+func isFloatVerb(verb rune) bool {
+	return strings.ContainsRune("vsbeEfFgGxX", verb)
+}
+
+// componentFormat returns the format directive of f and verb for a single
+// component, without the plus flag unless plus is true. The verb %s is
+// replaced by %v.
+func componentFormat(f fmt.State, verb rune, plus bool) string {
+	if verb == 's' {
+		verb = 'v'
+	}
+	format := fmt.FormatString(f, verb)
+	if !plus {
+		format = strings.Replace(format, "+", "", 1)
+	}
+	return format
+}
+
+// formatTuple writes the components cs to f as a parenthesized tuple like
+// String, with each component formatted according to f and verb. With the
+// plus flag, %v and %s prefix the components with the corresponding letter
+// of names instead of passing the flag on.
+func formatTuple[T Float](f fmt.State, verb rune, names string, cs ...T) {
+	withNames := (verb == 'v' || verb == 's') && f.Flag('+')
+	format := componentFormat(f, verb, !withNames)
+	f.Write([]byte{'('})
+	for i, c := range cs {
+		if i > 0 {
+			f.Write([]byte(", "))
+		}
+		if withNames {
+			f.Write([]byte{names[i], ':', ' '})
+		}
+		fmt.Fprintf(f, format, c)
+	}
+	f.Write([]byte{')'})
+}
+
+// formatTable writes the n x n matrix elements es to f with one inner tuple
+// per line, right-aligning the elements within their columns.
+func formatTable[T Float](f fmt.State, verb rune, es []T, n int) {
+	format := componentFormat(f, verb, false)
+	cells := make([]string, len(es))
+	widths := make([]int, n)
+	for i, e := range es {
+		cells[i] = fmt.Sprintf(format, e)
+		widths[i%n] = max(widths[i%n], len(cells[i]))
+	}
+	var b strings.Builder
+	b.WriteByte('(')
+	for i, s := range cells {
+		col := i % n
+		switch {
+		case i == 0:
+			b.WriteByte('(')
+		case col == 0:
+			b.WriteString("),\n (")
+		default:
+			b.WriteString(", ")
+		}
+		pad := strings.Repeat(" ", widths[col]-len(s))
+		if f.Flag('-') {
+			b.WriteString(s + pad)
+		} else {
+			b.WriteString(pad + s)
+		}
+	}
+	b.WriteString("))")
+	f.Write([]byte(b.String()))
+}
+
+// badVerb writes an error for an unsupported verb in the style of the fmt
+// package, like "%!d(geom.Vector2[float32]=(1, 2))".
+func badVerb(f fmt.State, verb rune, v fmt.Stringer) {
+	fmt.Fprintf(f, "%%!%c(%T=%s)", verb, v, v.String())
+}
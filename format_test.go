@@ -0,0 +1,64 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	v2 := V2(3.25, -1.5)
+	v3 := V3(1, 2, 3)
+	r := Rect(0, -1, 4, 3.5)
+	m := Mat4{{1, 0, 0, 0}, {0, 2.5, 0, 0}, {0, 0, 1, 0}, {-3, 4, 5, 1}}
+	tests := []struct {
+		format string
+		v      any
+		want   string
+	}{
+		{"%v", v2, "(3.25, -1.5)"},
+		{"%s", v2, "(3.25, -1.5)"},
+		{"%.3f", v2, "(3.250, -1.500)"},
+		{"%e", v2, "(3.250000e+00, -1.500000e+00)"},
+		{"%.2E", v2, "(3.25E+00, -1.50E+00)"},
+		{"%+v", v2, "(X: 3.25, Y: -1.5)"},
+		{"%+.1f", v2, "(+3.2, -1.5)"},
+		{"%6.2f", v2, "(  3.25,  -1.50)"},
+		{"%-6v|", v2, "(3.25  , -1.5  )|"},
+		{"%#v", v2, "geom.Vector2[float32]{X:3.25, Y:-1.5}"},
+		{"%#v", V2d(1, 0.5), "geom.Vector2[float64]{X:1, Y:0.5}"},
+		{"%d", v2, "%!d(geom.Vector2[float32]=(3.25, -1.5))"},
+		{"%v", v3, "(1, 2, 3)"},
+		{"%.1f", v3, "(1.0, 2.0, 3.0)"},
+		{"%+v", v3, "(X: 1, Y: 2, Z: 3)"},
+		{"%+4v", v3, "(X:    1, Y:    2, Z:    3)"},
+		{"%#v", v3, "geom.Vector3[float32]{X:1, Y:2, Z:3}"},
+		{"%v", r, "(0, -1)-(4, 3.5)"},
+		{"%.1f", r, "(0.0, -1.0)-(4.0, 3.5)"},
+		{"%+v", r, "(Min: (X: 0, Y: -1), Max: (X: 4, Y: 3.5))"},
+		{"%#v", r, "geom.Rectangle{Min:geom.Vector2[float32]{X:0, Y:-1}, Max:geom.Vector2[float32]{X:4, Y:3.5}}"},
+		{"%q", r, `%!q(geom.Rectangle=(0, -1)-(4, 3.5))`},
+		{"%v", m, "((1, 0, 0, 0), (0, 2.5, 0, 0), (0, 0, 1, 0), (-3, 4, 5, 1))"},
+		{"%.1f", m, "((1.0, 0.0, 0.0, 0.0), (0.0, 2.5, 0.0, 0.0), (0.0, 0.0, 1.0, 0.0), (-3.0, 4.0, 5.0, 1.0))"},
+		{"%+v", m, "(( 1,   0, 0, 0),\n ( 0, 2.5, 0, 0),\n ( 0,   0, 1, 0),\n (-3,   4, 5, 1))"},
+		{"%+.2f", m, "(( 1.00, 0.00, 0.00, 0.00),\n ( 0.00, 2.50, 0.00, 0.00),\n ( 0.00, 0.00, 1.00, 0.00),\n (-3.00, 4.00, 5.00, 1.00))"},
+		{"%-+v", m, "((1 , 0  , 0, 0),\n (0 , 2.5, 0, 0),\n (0 , 0  , 1, 0),\n (-3, 4  , 5, 1))"},
+		{"%#v", Mat4(id), "geom.Matrix4[float32]{[4]float32{1, 0, 0, 0}, [4]float32{0, 1, 0, 0}, [4]float32{0, 0, 1, 0}, [4]float32{0, 0, 0, 1}}"},
+	}
+	for _, tt := range tests {
+		if s := fmt.Sprintf(tt.format, tt.v); s != tt.want {
+			t.Errorf("Sprintf(%q, %v) =\n%s\nwant\n%s", tt.format, tt.v, s, tt.want)
+		}
+	}
+}
+
+func TestFormatMat4Parse(t *testing.T) {
+	s := fmt.Sprintf("%+v", *a)
+	m, err := ParseMat4(s)
+	if err != nil || m != *a {
+		t.Errorf("ParseMat4(%q) = %v, %v, want %v, nil", s, m, err, *a)
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"math"
+	"unsafe"
+)
+
+// A Matrix3 represents a 3x3 matrix. Like for Matrix4, the indices are
+// [column][row] for transformations of column vectors.
+// It has the same element layout as the upper-left 3x3 part of a Matrix4.
+type Matrix3[T Float] [3][3]T
+
+// A Mat3 is a 3x3 matrix with float32 elements.
+type Mat3 = Matrix3[float32]
+
+// A Mat3d is a 3x3 matrix with float64 elements.
+type Mat3d = Matrix3[float64]
+
+// id3 is the 3x3 identity matrix.
+var id3 = Mat3{
+	{1, 0, 0},
+	{0, 1, 0},
+	{0, 0, 1},
+}
+
+// zero3 is the 3x3 zero matrix.
+var zero3 Mat3
+
+// ID sets m to the identity matrix and returns m.
+func (m *Matrix3[T]) ID() *Matrix3[T] {
+	*m = Matrix3[T]{
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 1},
+	}
+	return m
+}
+
+// Zero sets all elements of m to 0 (zero matrix) and returns m.
+func (m *Matrix3[T]) Zero() *Matrix3[T] {
+	*m = Matrix3[T]{}
+	return m
+}
+
+// Det calculates the determinant of 3x3 matrix m.
+func (m *Matrix3[T]) Det() T {
+	return m[0][0]*(m[1][1]*m[2][2]-m[2][1]*m[1][2]) -
+		m[1][0]*(m[0][1]*m[2][2]-m[2][1]*m[0][2]) +
+		m[2][0]*(m[0][1]*m[1][2]-m[1][1]*m[0][2])
+}
+
+// Adj sets m to the adjugate (classical adjoint) of matrix a and returns m.
+// The adjugate is the transpose of the cofactor matrix of a.
+func (m *Matrix3[T]) Adj(a *Matrix3[T]) *Matrix3[T] {
+	*m = Matrix3[T]{
+		{
+			a[1][1]*a[2][2] - a[2][1]*a[1][2],
+			a[2][1]*a[0][2] - a[0][1]*a[2][2],
+			a[0][1]*a[1][2] - a[1][1]*a[0][2],
+		},
+		{
+			a[2][0]*a[1][2] - a[1][0]*a[2][2],
+			a[0][0]*a[2][2] - a[2][0]*a[0][2],
+			a[1][0]*a[0][2] - a[0][0]*a[1][2],
+		},
+		{
+			a[1][0]*a[2][1] - a[2][0]*a[1][1],
+			a[2][0]*a[0][1] - a[0][0]*a[2][1],
+			a[0][0]*a[1][1] - a[1][0]*a[0][1],
+		},
+	}
+	return m
+}
+
+// Inv sets m to the inverse of matrix a and returns m.
+// If a is singular the elements of m will be infinite or NaN;
+// use TryInv to detect this case.
+func (m *Matrix3[T]) Inv(a *Matrix3[T]) *Matrix3[T] {
+	d := 1 / a.Det()
+	m.Adj(a)
+	for i := range 3 {
+		for j := range 3 {
+			m[i][j] *= d
+		}
+	}
+	return m
+}
+
+// TryInv sets m to the inverse of matrix a and reports whether a was
+// invertible. If a is singular, m is left unchanged and TryInv returns false.
+func (m *Matrix3[T]) TryInv(a *Matrix3[T]) bool {
+	d := 1 / a.Det()
+	if math.IsInf(float64(d), 0) || math.IsNaN(float64(d)) {
+		return false
+	}
+	m.Adj(a)
+	for i := range 3 {
+		for j := range 3 {
+			m[i][j] *= d
+		}
+	}
+	return true
+}
+
+// Mul sets m to the matrix product a*b and returns m.
+func (m *Matrix3[T]) Mul(a *Matrix3[T], b *Matrix3[T]) *Matrix3[T] {
+	*m = Matrix3[T]{
+		{
+			a[0][0]*b[0][0] + a[1][0]*b[0][1] + a[2][0]*b[0][2],
+			a[0][1]*b[0][0] + a[1][1]*b[0][1] + a[2][1]*b[0][2],
+			a[0][2]*b[0][0] + a[1][2]*b[0][1] + a[2][2]*b[0][2],
+		},
+		{
+			a[0][0]*b[1][0] + a[1][0]*b[1][1] + a[2][0]*b[1][2],
+			a[0][1]*b[1][0] + a[1][1]*b[1][1] + a[2][1]*b[1][2],
+			a[0][2]*b[1][0] + a[1][2]*b[1][1] + a[2][2]*b[1][2],
+		},
+		{
+			a[0][0]*b[2][0] + a[1][0]*b[2][1] + a[2][0]*b[2][2],
+			a[0][1]*b[2][0] + a[1][1]*b[2][1] + a[2][1]*b[2][2],
+			a[0][2]*b[2][0] + a[1][2]*b[2][1] + a[2][2]*b[2][2],
+		},
+	}
+	return m
+}
+
+// MulVec3 returns the vector v transformed by matrix m.
+func (m *Matrix3[T]) MulVec3(v Vector3[T]) Vector3[T] {
+	return Vector3[T]{
+		m[0][0]*v.X + m[1][0]*v.Y + m[2][0]*v.Z,
+		m[0][1]*v.X + m[1][1]*v.Y + m[2][1]*v.Z,
+		m[0][2]*v.X + m[1][2]*v.Y + m[2][2]*v.Z,
+	}
+}
+
+// T sets m to the transpose of matrix a and returns m.
+func (m *Matrix3[T]) T(a *Matrix3[T]) *Matrix3[T] {
+	*m = Matrix3[T]{
+		{a[0][0], a[1][0], a[2][0]},
+		{a[0][1], a[1][1], a[2][1]},
+		{a[0][2], a[1][2], a[2][2]},
+	}
+	return m
+}
+
+// FromMat4 sets m to the upper-left 3x3 part of matrix a and returns m.
+func (m *Matrix3[T]) FromMat4(a *Matrix4[T]) *Matrix3[T] {
+	*m = Matrix3[T]{
+		{a[0][0], a[0][1], a[0][2]},
+		{a[1][0], a[1][1], a[1][2]},
+		{a[2][0], a[2][1], a[2][2]},
+	}
+	return m
+}
+
+// NormalMatrix sets m to the normal matrix of the model-view matrix a and
+// returns m. The normal matrix is the inverse transpose of the upper-left
+// 3x3 part of a. It transforms surface normals so that they stay
+// perpendicular to the surface under non-uniform scaling.
+func (m *Matrix3[T]) NormalMatrix(a *Matrix4[T]) *Matrix3[T] {
+	var b Matrix3[T]
+	b.FromMat4(a)
+	d := 1 / b.Det()
+	// The inverse transpose is the cofactor matrix divided by the determinant.
+	m.T(b.Adj(&b))
+	for i := range 3 {
+		for j := range 3 {
+			m[i][j] *= d
+		}
+	}
+	return m
+}
+
+// Floats returns a pointer to the matrix elements represented as a flat
+// array of numbers in the order of the indices, which is column-major for
+// column vectors. Changing an element value of this array will affect m
+// and vice versa.
+func (m *Matrix3[T]) Floats() *[9]T {
+	return (*[9]T)(unsafe.Pointer(m))
+}
+
+// nearEq returns whether m and m2 are approximately equal. This relation is
+// not transitive in general. The tolerance for the floating-point components
+// is ±1e-5.
+func (m *Matrix3[T]) nearEq(m2 *Matrix3[T]) bool {
+	for i := range 3 {
+		for j := range 3 {
+			if !nearEq(m[i][j], m2[i][j], epsilon) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// String returns a string representation of m like
+// "((1, 0, 0), (0, 1, 0), (0, 0, 1))", with the elements in the order of
+// the indices.
+func (m Matrix3[T]) String() string {
+	s := "("
+	for i := range 3 {
+		if i > 0 {
+			s += ", "
+		}
+		s += "("
+		for j := range 3 {
+			if j > 0 {
+				s += ", "
+			}
+			s += str(m[i][j])
+		}
+		s += ")"
+	}
+	return s + ")"
+}
+
+// Float32 returns m with its elements converted to float32.
+func (m *Matrix3[T]) Float32() Mat3 {
+	var r Mat3
+	for i := range 3 {
+		for j := range 3 {
+			r[i][j] = float32(m[i][j])
+		}
+	}
+	return r
+}
+
+// Float64 returns m with its elements converted to float64.
+func (m *Matrix3[T]) Float64() Mat3d {
+	var r Mat3d
+	for i := range 3 {
+		for j := range 3 {
+			r[i][j] = float64(m[i][j])
+		}
+	}
+	return r
+}
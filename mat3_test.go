@@ -0,0 +1,351 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMat3NearEq(t *testing.T) {
+	tests := []struct {
+		a, b Mat3
+		want bool
+	}{
+		{Mat3{
+			{11, 12, 13},
+			{21, 22, 23},
+			{31, 32, 33},
+		}, Mat3{
+			{11, 12, 13},
+			{21, 22, 23},
+			{31, 32, 33},
+		}, true},
+
+		{Mat3{
+			{11, 12, 13},
+			{21, 22, 23},
+			{31, 32, 33},
+		}, Mat3{
+			{11.000001, 12.000001, 13.000001},
+			{21.000001, 22.000001, 23.000001},
+			{31.000001, 32.000001, 33.000001},
+		}, true},
+
+		{Mat3{
+			{11, 12, 13},
+			{21, 22, 23},
+			{31, 32, 33},
+		}, Mat3{
+			{10.99999, 11.99999, 12.99999},
+			{20.99999, 21.99999, 22.99999},
+			{30.99999, 31.99999, 32.99999},
+		}, false},
+	}
+	for _, tt := range tests {
+		x := tt.a.nearEq(&tt.b)
+		if x != tt.want {
+			t.Errorf("%v.nearEq(%v) = %v, want %v", tt.a, tt.b, x, tt.want)
+		}
+	}
+}
+
+func TestMat3ID(t *testing.T) {
+	m := Mat3{
+		{11, 12, 13},
+		{21, 22, 23},
+		{31, 32, 33},
+	}
+	id := Mat3{
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 1},
+	}
+	mp := m.ID()
+	if m != id {
+		t.Errorf("m.ID() does not set m to the identity matrix, got instead: %v", m)
+	}
+	if mp != &m {
+		t.Errorf("m.ID() does not return the pointer to m")
+	}
+}
+
+func TestMat3Zero(t *testing.T) {
+	m := Mat3{
+		{11, 12, 13},
+		{21, 22, 23},
+		{31, 32, 33},
+	}
+	mp := m.Zero()
+	if m != (Mat3{}) {
+		t.Errorf("m.Zero() does not set m to the zero matrix, got instead: %v", m)
+	}
+	if mp != &m {
+		t.Errorf("m.Zero() does not return the pointer to m")
+	}
+}
+
+func TestMat3Det(t *testing.T) {
+	tests := []struct {
+		m    Mat3
+		want float32
+	}{
+		{id3, 1},
+		{Mat3{
+			{2, 0, 0},
+			{0, 3, 0},
+			{0, 0, 4},
+		}, 24},
+		{Mat3{
+			{-3, 2, 6},
+			{4, 1.5, 1},
+			{1, 4, 2},
+		}, 76},
+		{Mat3{
+			{1, 2, 3},
+			{4, 5, 6},
+			{7, 8, 9},
+		}, 0},
+	}
+	for _, tt := range tests {
+		if det := tt.m.Det(); det != tt.want {
+			t.Errorf("%v.Det() = %g, want %g", tt.m, det, tt.want)
+		}
+	}
+}
+
+func TestMat3Mul(t *testing.T) {
+	tests := []struct {
+		a, b, want Mat3
+	}{
+		{Mat3{
+			{1, 2, 3},
+			{4, 5, 6},
+			{7, 8, 9},
+		}, id3, Mat3{
+			{1, 2, 3},
+			{4, 5, 6},
+			{7, 8, 9},
+		}},
+		{Mat3{
+			{1, 2, 3},
+			{4, 5, 6},
+			{7, 8, 9},
+		}, Mat3{
+			{2, 0, 1},
+			{-1, 3, 0.5},
+			{0, 1, 4},
+		}, Mat3{
+			{9, 12, 15},
+			{14.5, 17, 19.5},
+			{32, 37, 42},
+		}},
+	}
+	for _, tt := range tests {
+		var m Mat3
+		mp := m.Mul(&tt.a, &tt.b)
+		if !tt.want.nearEq(&m) {
+			t.Errorf("%v * %v = %v, want %v", tt.a, tt.b, m, tt.want)
+		}
+		if mp != &m {
+			t.Errorf("m.Mul(...) does not return the pointer to m")
+		}
+	}
+}
+
+func TestMat3MulVec3(t *testing.T) {
+	var rot, scale Mat4
+	rot.ID().Rot(&rot, math.Pi/2, V3UnitZ)
+	scale.ID().Scale(&scale, V3(2, 3, -4))
+	var rot3, scale3 Mat3
+	rot3.FromMat4(&rot)
+	scale3.FromMat4(&scale)
+
+	tests := []struct {
+		m    *Mat3
+		v    Vec3
+		want Vec3
+	}{
+		{&id3, V3(1, 2, 3), V3(1, 2, 3)},
+		{&rot3, V3(1, 0, 2), V3(0, 1, 2)},
+		{&scale3, V3(1.5, -3, -1), V3(3, -9, 4)},
+	}
+	for _, tt := range tests {
+		if x := tt.m.MulVec3(tt.v); !x.NearEq(tt.want) {
+			t.Errorf("%v.MulVec3(%s) = %s, want %s", *tt.m, tt.v, x, tt.want)
+		}
+	}
+}
+
+func TestMat3T(t *testing.T) {
+	a := Mat3{
+		{11, 12, 13},
+		{21, 22, 23},
+		{31, 32, 33},
+	}
+	want := Mat3{
+		{11, 21, 31},
+		{12, 22, 32},
+		{13, 23, 33},
+	}
+	var m Mat3
+	mp := m.T(&a)
+	if m != want {
+		t.Errorf("m.T(%v) = %v, want %v", a, m, want)
+	}
+	if mp != &m {
+		t.Errorf("m.T(...) does not return the pointer to m")
+	}
+}
+
+func TestMat3Inv(t *testing.T) {
+	tests := []struct {
+		a, want Mat3
+	}{
+		{id3, id3},
+		{Mat3{
+			{2, 0, 0},
+			{0, 4, 0},
+			{0, 0, 5},
+		}, Mat3{
+			{0.5, 0, 0},
+			{0, 0.25, 0},
+			{0, 0, 0.2},
+		}},
+		{Mat3{
+			{-3, 2, 6},
+			{4, 1.5, 1},
+			{1, 4, 2},
+		}, Mat3{
+			{-0.013157895, 0.2631579, -0.09210526},
+			{-0.09210526, -0.15789473, 0.35526317},
+			{0.19078948, 0.18421052, -0.16447368},
+		}},
+	}
+	for _, tt := range tests {
+		var m Mat3
+		mp := m.Inv(&tt.a)
+		if !tt.want.nearEq(&m) {
+			t.Errorf("m.Inv(%v) = %v, want %v", tt.a, m, tt.want)
+		}
+		if mp != &m {
+			t.Errorf("m.Inv(...) does not return the pointer to m")
+		}
+		var p Mat3
+		if p.Mul(&tt.a, &m); !p.nearEq(&id3) {
+			t.Errorf("%v * %v = %v, want identity", tt.a, m, p)
+		}
+	}
+}
+
+func TestMat3TryInv(t *testing.T) {
+	tests := []struct {
+		a    Mat3
+		want bool
+	}{
+		{id3, true},
+		{Mat3{
+			{-3, 2, 6},
+			{4, 1.5, 1},
+			{1, 4, 2},
+		}, true},
+		{zero3, false},
+		{Mat3{
+			{1, 2, 3},
+			{4, 5, 6},
+			{7, 8, 9},
+		}, false},
+	}
+	for _, tt := range tests {
+		m := Mat3{{9, 9, 9}}
+		before := m
+		if ok := m.TryInv(&tt.a); ok != tt.want {
+			t.Errorf("m.TryInv(%v) = %v, want %v", tt.a, ok, tt.want)
+			continue
+		}
+		if !tt.want && m != before {
+			t.Errorf("m.TryInv(%v) changed m to %v for singular matrix", tt.a, m)
+		}
+	}
+}
+
+func TestMat3FromMat4(t *testing.T) {
+	a := Mat4{
+		{11, 12, 13, 14},
+		{21, 22, 23, 24},
+		{31, 32, 33, 34},
+		{41, 42, 43, 44},
+	}
+	want := Mat3{
+		{11, 12, 13},
+		{21, 22, 23},
+		{31, 32, 33},
+	}
+	var m Mat3
+	mp := m.FromMat4(&a)
+	if m != want {
+		t.Errorf("m.FromMat4(%v) = %v, want %v", a, m, want)
+	}
+	if mp != &m {
+		t.Errorf("m.FromMat4(...) does not return the pointer to m")
+	}
+
+	var m4 Mat4
+	want4 := Mat4{
+		{11, 12, 13, 0},
+		{21, 22, 23, 0},
+		{31, 32, 33, 0},
+		{0, 0, 0, 1},
+	}
+	if m4.FromMat3(&m); m4 != want4 {
+		t.Errorf("m.FromMat3(%v) = %v, want %v", m, m4, want4)
+	}
+}
+
+func TestMat3NormalMatrix(t *testing.T) {
+	var a Mat4
+	a.ID().Translate(&a, V3(3, -2, 1)).Rot(&a, 0.8, V3(1, 1, 0)).Scale(&a, V3(2, 0.5, 4))
+
+	var m Mat3
+	mp := m.NormalMatrix(&a)
+	if mp != &m {
+		t.Errorf("m.NormalMatrix(...) does not return the pointer to m")
+	}
+
+	// The normal matrix is the inverse transpose of the upper-left 3x3 part.
+	var want Mat3
+	want.FromMat4(&a)
+	want.Inv(&want)
+	want.T(&want)
+	if !want.nearEq(&m) {
+		t.Errorf("m.NormalMatrix(%v) = %v, want %v", a, m, want)
+	}
+
+	// A transformed normal stays perpendicular to a transformed tangent.
+	tangent := V3(1, -1, 0)
+	normal := V3(1, 1, 2)
+	var a3 Mat3
+	a3.FromMat4(&a)
+	if d := a3.MulVec3(tangent).Dot(m.MulVec3(normal)); !nearEq(d, 0, epsilon) {
+		t.Errorf("transformed tangent and normal not perpendicular, dot product = %g", d)
+	}
+}
+
+func TestMat3Floats(t *testing.T) {
+	m := Mat3{
+		{11, 12, 13},
+		{21, 22, 23},
+		{31, 32, 33},
+	}
+	want := [9]float32{11, 12, 13, 21, 22, 23, 31, 32, 33}
+	f := m.Floats()
+	if *f != want {
+		t.Errorf("%v.Floats() = %v, want %v", m, *f, want)
+	}
+	f[5] = 99
+	if m[1][2] != 99 {
+		t.Errorf("Pointer to float32 array returned by Floats() does not point to matrix data.")
+	}
+}
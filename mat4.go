@@ -9,8 +9,19 @@ import (
 	"unsafe"
 )
 
-// A Mat4 represents a 4x4 matrix. The indices are [row][column].
-type Mat4 [4][4]float32
+// A Matrix4 represents a 4x4 matrix. The indices are [column][row] for
+// transformations of column vectors like in OpenGL and GLSL, i.e. m[3]
+// holds the translation. Equivalently, the indices are [row][column] for
+// transformations of row vectors like in DirectXMath. Either way the
+// elements are stored in the memory layout that graphics APIs expect by
+// default. See the package documentation for a table of the conventions.
+type Matrix4[T Float] [4][4]T
+
+// A Mat4 is a 4x4 matrix with float32 elements.
+type Mat4 = Matrix4[float32]
+
+// A Mat4d is a 4x4 matrix with float64 elements.
+type Mat4d = Matrix4[float64]
 
 // id is the 4x4 identity matrix.
 var id = Mat4{
@@ -24,19 +35,24 @@ var id = Mat4{
 var zero Mat4
 
 // ID sets m to the identity matrix and returns m.
-func (m *Mat4) ID() *Mat4 {
-	*m = id
+func (m *Matrix4[T]) ID() *Matrix4[T] {
+	*m = Matrix4[T]{
+		{1, 0, 0, 0},
+		{0, 1, 0, 0},
+		{0, 0, 1, 0},
+		{0, 0, 0, 1},
+	}
 	return m
 }
 
 // Zero sets all elements of m to 0 (zero matrix) and returns m.
-func (m *Mat4) Zero() *Mat4 {
-	*m = zero
+func (m *Matrix4[T]) Zero() *Matrix4[T] {
+	*m = Matrix4[T]{}
 	return m
 }
 
 // Det calculates the determinant of 4x4 matrix m.
-func (m *Mat4) Det() float32 {
+func (m *Matrix4[T]) Det() T {
 	return m[0][3]*m[1][2]*m[2][1]*m[3][0] - m[0][2]*m[1][3]*m[2][1]*m[3][0] -
 		m[0][3]*m[1][1]*m[2][2]*m[3][0] + m[0][1]*m[1][3]*m[2][2]*m[3][0] +
 		m[0][2]*m[1][1]*m[2][3]*m[3][0] - m[0][1]*m[1][2]*m[2][3]*m[3][0] -
@@ -51,9 +67,139 @@ func (m *Mat4) Det() float32 {
 		m[0][1]*m[1][0]*m[2][2]*m[3][3] + m[0][0]*m[1][1]*m[2][2]*m[3][3]
 }
 
+// Adj sets m to the adjugate (classical adjoint) of matrix a and returns m.
+// The adjugate is the transpose of the cofactor matrix of a.
+func (m *Matrix4[T]) Adj(a *Matrix4[T]) *Matrix4[T] {
+	s0 := a[0][0]*a[1][1] - a[1][0]*a[0][1]
+	s1 := a[0][0]*a[1][2] - a[1][0]*a[0][2]
+	s2 := a[0][0]*a[1][3] - a[1][0]*a[0][3]
+	s3 := a[0][1]*a[1][2] - a[1][1]*a[0][2]
+	s4 := a[0][1]*a[1][3] - a[1][1]*a[0][3]
+	s5 := a[0][2]*a[1][3] - a[1][2]*a[0][3]
+
+	c5 := a[2][2]*a[3][3] - a[3][2]*a[2][3]
+	c4 := a[2][1]*a[3][3] - a[3][1]*a[2][3]
+	c3 := a[2][1]*a[3][2] - a[3][1]*a[2][2]
+	c2 := a[2][0]*a[3][3] - a[3][0]*a[2][3]
+	c1 := a[2][0]*a[3][2] - a[3][0]*a[2][2]
+	c0 := a[2][0]*a[3][1] - a[3][0]*a[2][1]
+
+	*m = Matrix4[T]{
+		{
+			a[1][1]*c5 - a[1][2]*c4 + a[1][3]*c3,
+			-a[0][1]*c5 + a[0][2]*c4 - a[0][3]*c3,
+			a[3][1]*s5 - a[3][2]*s4 + a[3][3]*s3,
+			-a[2][1]*s5 + a[2][2]*s4 - a[2][3]*s3,
+		},
+		{
+			-a[1][0]*c5 + a[1][2]*c2 - a[1][3]*c1,
+			a[0][0]*c5 - a[0][2]*c2 + a[0][3]*c1,
+			-a[3][0]*s5 + a[3][2]*s2 - a[3][3]*s1,
+			a[2][0]*s5 - a[2][2]*s2 + a[2][3]*s1,
+		},
+		{
+			a[1][0]*c4 - a[1][1]*c2 + a[1][3]*c0,
+			-a[0][0]*c4 + a[0][1]*c2 - a[0][3]*c0,
+			a[3][0]*s4 - a[3][1]*s2 + a[3][3]*s0,
+			-a[2][0]*s4 + a[2][1]*s2 - a[2][3]*s0,
+		},
+		{
+			-a[1][0]*c3 + a[1][1]*c1 - a[1][2]*c0,
+			a[0][0]*c3 - a[0][1]*c1 + a[0][2]*c0,
+			-a[3][0]*s3 + a[3][1]*s1 - a[3][2]*s0,
+			a[2][0]*s3 - a[2][1]*s1 + a[2][2]*s0,
+		},
+	}
+	return m
+}
+
+// Inv sets m to the inverse of matrix a and returns m.
+// If a is singular the elements of m will be infinite or NaN;
+// use TryInv to detect this case.
+func (m *Matrix4[T]) Inv(a *Matrix4[T]) *Matrix4[T] {
+	d := 1 / a.Det()
+	m.Adj(a)
+	for i := range 4 {
+		for j := range 4 {
+			m[i][j] *= d
+		}
+	}
+	return m
+}
+
+// TryInv sets m to the inverse of matrix a and reports whether a was
+// invertible. If a is singular, m is left unchanged and TryInv returns false.
+func (m *Matrix4[T]) TryInv(a *Matrix4[T]) bool {
+	d := 1 / a.Det()
+	if math.IsInf(float64(d), 0) || math.IsNaN(float64(d)) {
+		return false
+	}
+	m.Adj(a)
+	for i := range 4 {
+		for j := range 4 {
+			m[i][j] *= d
+		}
+	}
+	return true
+}
+
+// InvAffine sets m to the inverse of the affine transformation matrix a and
+// returns m. It is faster than Inv, but the result is only correct if a
+// has no projective part, i.e. if it was built from translations, rotations,
+// scalings and shearings.
+func (m *Matrix4[T]) InvAffine(a *Matrix4[T]) *Matrix4[T] {
+	// Inverse of the upper-left 3x3 block via its adjugate.
+	b00 := a[1][1]*a[2][2] - a[2][1]*a[1][2]
+	b01 := a[2][1]*a[0][2] - a[0][1]*a[2][2]
+	b02 := a[0][1]*a[1][2] - a[1][1]*a[0][2]
+	b10 := a[2][0]*a[1][2] - a[1][0]*a[2][2]
+	b11 := a[0][0]*a[2][2] - a[2][0]*a[0][2]
+	b12 := a[1][0]*a[0][2] - a[0][0]*a[1][2]
+	b20 := a[1][0]*a[2][1] - a[2][0]*a[1][1]
+	b21 := a[2][0]*a[0][1] - a[0][0]*a[2][1]
+	b22 := a[0][0]*a[1][1] - a[1][0]*a[0][1]
+	d := 1 / (a[0][0]*b00 + a[1][0]*b01 + a[2][0]*b02)
+	b00, b01, b02 = b00*d, b01*d, b02*d
+	b10, b11, b12 = b10*d, b11*d, b12*d
+	b20, b21, b22 = b20*d, b21*d, b22*d
+	t := a[3]
+	*m = Matrix4[T]{
+		{b00, b01, b02, 0},
+		{b10, b11, b12, 0},
+		{b20, b21, b22, 0},
+		{
+			-(b00*t[0] + b10*t[1] + b20*t[2]),
+			-(b01*t[0] + b11*t[1] + b21*t[2]),
+			-(b02*t[0] + b12*t[1] + b22*t[2]),
+			1,
+		},
+	}
+	return m
+}
+
+// InvOrthonormal sets m to the inverse of matrix a and returns m. It is the
+// fastest of the inversion methods, but the result is only correct if a
+// consists of a rotation and a translation only, e.g. a view matrix built
+// with LookAt.
+func (m *Matrix4[T]) InvOrthonormal(a *Matrix4[T]) *Matrix4[T] {
+	t := a[3]
+	*m = Matrix4[T]{
+		{a[0][0], a[1][0], a[2][0], 0},
+		{a[0][1], a[1][1], a[2][1], 0},
+		{a[0][2], a[1][2], a[2][2], 0},
+		{
+			-(a[0][0]*t[0] + a[0][1]*t[1] + a[0][2]*t[2]),
+			-(a[1][0]*t[0] + a[1][1]*t[1] + a[1][2]*t[2]),
+			-(a[2][0]*t[0] + a[2][1]*t[1] + a[2][2]*t[2]),
+			1,
+		},
+	}
+	return m
+}
+
 // Mul sets m to the matrix product a*b and returns m.
-func (m *Mat4) Mul(a *Mat4, b *Mat4) *Mat4 {
-	*m = Mat4{
+func (m *Matrix4[T]) Mul(a *Matrix4[T], b *Matrix4[T]) *Matrix4[T] {
+	*m = Matrix4[T]{
 		{
 			a[0][0]*b[0][0] + a[1][0]*b[0][1] + a[2][0]*b[0][2] + a[3][0]*b[0][3],
 			a[0][1]*b[0][0] + a[1][1]*b[0][1] + a[2][1]*b[0][2] + a[3][1]*b[0][3],
@@ -83,12 +229,13 @@ func (m *Mat4) Mul(a *Mat4, b *Mat4) *Mat4 {
 }
 
 // Ortho sets m to an orthographic projection matrix with the given clipping
-// planes and returns m.
-func (m *Mat4) Ortho(left, right, bottom, top, near, far float32) *Mat4 {
+// planes for the clip space of OpenGL and returns m. See OrthoClip for
+// other clip spaces.
+func (m *Matrix4[T]) Ortho(left, right, bottom, top, near, far T) *Matrix4[T] {
 	dx := left - right
 	dy := bottom - top
 	dz := near - far
-	*m = Mat4{
+	*m = Matrix4[T]{
 		{-2 / dx, 0, 0, 0},
 		{0, -2 / dy, 0, 0},
 		{0, 0, 2 / dz, 0},
@@ -97,13 +244,13 @@ func (m *Mat4) Ortho(left, right, bottom, top, near, far float32) *Mat4 {
 	return m
 }
 
-// Frustum sets m to a frustum matrix with the given clipping planes and
-// returns m.
-func (m *Mat4) Frustum(left, right, bottom, top, near, far float32) *Mat4 {
+// Frustum sets m to a frustum matrix with the given clipping planes for the
+// clip space of OpenGL and returns m. See FrustumClip for other clip spaces.
+func (m *Matrix4[T]) Frustum(left, right, bottom, top, near, far T) *Matrix4[T] {
 	dx := right - left
 	dy := top - bottom
 	dz := near - far
-	*m = Mat4{
+	*m = Matrix4[T]{
 		{(2 * near) / dx, 0, 0, 0},
 		{0, (2 * near) / dy, 0, 0},
 		{(left + right) / dx, (top + bottom) / dy, (far + near) / dz, -1},
@@ -113,12 +260,13 @@ func (m *Mat4) Frustum(left, right, bottom, top, near, far float32) *Mat4 {
 }
 
 // Perspective sets m to a perspective matrix with the given vertical field of
-// view angle (in radians), aspect ratio, near and far bounds of the frustum,
-// and returns m.
-func (m *Mat4) Perspective(fovy, aspect, near, far float32) *Mat4 {
-	f := 1 / float32(math.Tan(float64(fovy/2)))
+// view angle (in radians), aspect ratio, near and far bounds of the frustum
+// for the clip space of OpenGL, and returns m. See PerspectiveClip for other
+// clip spaces.
+func (m *Matrix4[T]) Perspective(fovy, aspect, near, far T) *Matrix4[T] {
+	f := 1 / T(math.Tan(float64(fovy/2)))
 	dz := near - far
-	*m = Mat4{
+	*m = Matrix4[T]{
 		{f / aspect, 0, 0, 0},
 		{0, f, 0, 0},
 		{0, 0, (far + near) / dz, -1},
@@ -127,13 +275,166 @@ func (m *Mat4) Perspective(fovy, aspect, near, far float32) *Mat4 {
 	return m
 }
 
+// A DepthRange specifies the normalized device depths that the near and
+// far planes of a projection are mapped to.
+type DepthRange int
+
+const (
+	// DepthNegOneToOne maps the near plane to -1 and the far plane to 1,
+	// as expected by OpenGL by default.
+	DepthNegOneToOne DepthRange = iota
+
+	// DepthZeroToOne maps the near plane to 0 and the far plane to 1, as
+	// expected by Vulkan, Direct3D, Metal and WebGPU, and by OpenGL with
+	// glClipControl(GL_LOWER_LEFT, GL_ZERO_TO_ONE).
+	DepthZeroToOne
+
+	// DepthOneToZero maps the near plane to 1 and the far plane to 0 for
+	// reverse-Z with the APIs of DepthZeroToOne. Together with a
+	// floating-point depth buffer and a depth test for greater values,
+	// it distributes the depth precision almost uniformly.
+	DepthOneToZero
+)
+
+// Handedness specifies the orientation of a coordinate system.
+type Handedness int
+
+const (
+	// RightHanded coordinate systems have the Z axis pointing towards
+	// the viewer if X points right and Y points up, i.e. the camera
+	// looks along -Z, like in OpenGL.
+	RightHanded Handedness = iota
+
+	// LeftHanded coordinate systems have the Z axis pointing away from
+	// the viewer if X points right and Y points up, i.e. the camera looks
+	// along +Z, like in Direct3D.
+	LeftHanded
+)
+
+// forward returns the sign of the Z coordinate of points in front of the
+// camera in view space with the handedness h.
+func forward[T Float](h Handedness) T {
+	if h == LeftHanded {
+		return 1
+	}
+	return -1
+}
+
+// A ClipSpace specifies the conventions of a projection: the depth range
+// it maps to and the handedness of the view space it maps from. The zero
+// value are the conventions of OpenGL and of Ortho, Frustum and
+// Perspective.
+type ClipSpace struct {
+	Depth      DepthRange
+	Handedness Handedness
+}
+
+// OrthoClip sets m to an orthographic projection matrix with the given
+// clipping planes for the clip space c and returns m. The near and far
+// distances are measured in the viewing direction, and far must be finite.
+func (m *Matrix4[T]) OrthoClip(left, right, bottom, top, near, far T, c ClipSpace) *Matrix4[T] {
+	dx := right - left
+	dy := top - bottom
+	dz := far - near
+	var a, b T
+	switch c.Depth {
+	case DepthZeroToOne:
+		a, b = 1/dz, -near/dz
+	case DepthOneToZero:
+		a, b = -1/dz, far/dz
+	default:
+		a, b = 2/dz, -(far+near)/dz
+	}
+	s := forward[T](c.Handedness)
+	*m = Matrix4[T]{
+		{2 / dx, 0, 0, 0},
+		{0, 2 / dy, 0, 0},
+		{0, 0, s * a, 0},
+		{-(left + right) / dx, -(top + bottom) / dy, b, 1},
+	}
+	return m
+}
+
+// FrustumClip sets m to a frustum matrix with the given clipping planes for
+// the clip space c and returns m. The near and far distances are measured
+// in the viewing direction. The far distance may be infinite for a
+// projection without a far plane, i.e. T(math.Inf(1)).
+func (m *Matrix4[T]) FrustumClip(left, right, bottom, top, near, far T, c ClipSpace) *Matrix4[T] {
+	dx := right - left
+	dy := top - bottom
+	a, b := perspectiveDepth(c.Depth, near, far)
+	s := forward[T](c.Handedness)
+	*m = Matrix4[T]{
+		{(2 * near) / dx, 0, 0, 0},
+		{0, (2 * near) / dy, 0, 0},
+		{-s * (left + right) / dx, -s * (top + bottom) / dy, s * a, s},
+		{0, 0, b, 0},
+	}
+	return m
+}
+
+// PerspectiveClip sets m to a perspective matrix with the given vertical
+// field of view angle (in radians), aspect ratio, near and far bounds of
+// the frustum for the clip space c, and returns m. The far bound may be
+// infinite for a projection without a far plane, i.e. T(math.Inf(1)).
+// For example, an infinite reverse-Z projection for Vulkan is given by
+//
+//	m.PerspectiveClip(fovy, aspect, 0.1, float32(math.Inf(1)),
+//		geom.ClipSpace{Depth: geom.DepthOneToZero})
+//	m[1][1] = -m[1][1] // Vulkan's clip space Y axis points down
+func (m *Matrix4[T]) PerspectiveClip(fovy, aspect, near, far T, c ClipSpace) *Matrix4[T] {
+	f := 1 / T(math.Tan(float64(fovy/2)))
+	a, b := perspectiveDepth(c.Depth, near, far)
+	s := forward[T](c.Handedness)
+	*m = Matrix4[T]{
+		{f / aspect, 0, 0, 0},
+		{0, f, 0, 0},
+		{0, 0, s * a, s},
+		{0, 0, b, 0},
+	}
+	return m
+}
+
+// perspectiveDepth returns the coefficients a and b of a perspective
+// projection that maps the distance d in front of the camera to the clip
+// depth a*d + b for the clip W coordinate d. They are computed from the
+// ratio of near and far so that they have the correct limits for an
+// infinite far distance.
+func perspectiveDepth[T Float](depth DepthRange, near, far T) (a, b T) {
+	k := near / far
+	switch depth {
+	case DepthZeroToOne:
+		return 1 / (1 - k), -near / (1 - k)
+	case DepthOneToZero:
+		return k / (k - 1), near / (1 - k)
+	}
+	return (1 + k) / (1 - k), -2 * near / (1 - k)
+}
+
 // LookAt sets m to a viewing matrix given an eye point, a reference point
-// indicating the center of the scene and an up vector, and returns m.
-func (m *Mat4) LookAt(eye, center, up Vec3) *Mat4 {
+// indicating the center of the scene and an up vector, and returns m. The
+// view space is right-handed, i.e. the camera looks along its -Z axis.
+func (m *Matrix4[T]) LookAt(eye, center, up Vector3[T]) *Matrix4[T] {
 	vz := eye.Sub(center).Norm()
 	vx := up.Cross(vz).Norm()
 	vy := vz.Cross(vx)
-	*m = Mat4{
+	*m = Matrix4[T]{
+		{vx.X, vy.X, vz.X, 0},
+		{vx.Y, vy.Y, vz.Y, 0},
+		{vx.Z, vy.Z, vz.Z, 0},
+		{-vx.Dot(eye), -vy.Dot(eye), -vz.Dot(eye), 1},
+	}
+	return m
+}
+
+// LookAtLH sets m to a viewing matrix for a left-handed view space like
+// LookAt, i.e. the camera looks along the +Z axis of the view space, and
+// returns m. Use it with projections for LeftHanded clip spaces.
+func (m *Matrix4[T]) LookAtLH(eye, center, up Vector3[T]) *Matrix4[T] {
+	vz := center.Sub(eye).Norm()
+	vx := up.Cross(vz).Norm()
+	vy := vz.Cross(vx)
+	*m = Matrix4[T]{
 		{vx.X, vy.X, vz.X, 0},
 		{vx.Y, vy.Y, vz.Y, 0},
 		{vx.Z, vy.Z, vz.Z, 0},
@@ -144,12 +445,12 @@ func (m *Mat4) LookAt(eye, center, up Vec3) *Mat4 {
 
 // Rot sets m to the rotation of matrix a by the given angle in radians around
 // the given axis, and returns m.
-func (m *Mat4) Rot(a *Mat4, angle float32, axis Vec3) *Mat4 {
-	c := float32(math.Cos(float64(angle)))
-	s := float32(math.Sin(float64(angle)))
+func (m *Matrix4[T]) Rot(a *Matrix4[T], angle T, axis Vector3[T]) *Matrix4[T] {
+	c := T(math.Cos(float64(angle)))
+	s := T(math.Sin(float64(angle)))
 	t := 1 - c
 	n := axis.Norm()
-	b := Mat4{
+	b := Matrix4[T]{
 		{n.X*n.X*t + c, n.Y*n.X*t + n.Z*s, n.Z*n.X*t - n.Y*s, 0},
 		{n.X*n.Y*t - n.Z*s, n.Y*n.Y*t + c, n.Z*n.Y*t + n.X*s, 0},
 		{n.X*n.Z*t + n.Y*s, n.Y*n.Z*t - n.X*s, n.Z*n.Z*t + c, 0},
@@ -158,9 +459,24 @@ func (m *Mat4) Rot(a *Mat4, angle float32, axis Vec3) *Mat4 {
 	return m.Mul(a, &b)
 }
 
+// RotQuat sets m to the rotation of matrix a by the unit quaternion q,
+// and returns m.
+func (m *Matrix4[T]) RotQuat(a *Matrix4[T], q Quaternion[T]) *Matrix4[T] {
+	xx, yy, zz := q.X*q.X, q.Y*q.Y, q.Z*q.Z
+	xy, xz, yz := q.X*q.Y, q.X*q.Z, q.Y*q.Z
+	wx, wy, wz := q.W*q.X, q.W*q.Y, q.W*q.Z
+	b := Matrix4[T]{
+		{1 - 2*(yy+zz), 2 * (xy + wz), 2 * (xz - wy), 0},
+		{2 * (xy - wz), 1 - 2*(xx+zz), 2 * (yz + wx), 0},
+		{2 * (xz + wy), 2 * (yz - wx), 1 - 2*(xx+yy), 0},
+		{0, 0, 0, 1},
+	}
+	return m.Mul(a, &b)
+}
+
 // T sets m to the transpose of matrix a and returns m.
-func (m *Mat4) T(a *Mat4) *Mat4 {
-	*m = Mat4{
+func (m *Matrix4[T]) T(a *Matrix4[T]) *Matrix4[T] {
+	*m = Matrix4[T]{
 		{a[0][0], a[1][0], a[2][0], a[3][0]},
 		{a[0][1], a[1][1], a[2][1], a[3][1]},
 		{a[0][2], a[1][2], a[2][2], a[3][2]},
@@ -171,8 +487,8 @@ func (m *Mat4) T(a *Mat4) *Mat4 {
 
 // Scale sets m to the scaling of matrix a by the scale factors of v and
 // returns m.
-func (m *Mat4) Scale(a *Mat4, v Vec3) *Mat4 {
-	*m = Mat4{
+func (m *Matrix4[T]) Scale(a *Matrix4[T], v Vector3[T]) *Matrix4[T] {
+	*m = Matrix4[T]{
 		{a[0][0] * v.X, a[0][1] * v.X, a[0][2] * v.X, a[0][3] * v.X},
 		{a[1][0] * v.Y, a[1][1] * v.Y, a[1][2] * v.Y, a[1][3] * v.Y},
 		{a[2][0] * v.Z, a[2][1] * v.Z, a[2][2] * v.Z, a[2][3] * v.Z},
@@ -183,8 +499,8 @@ func (m *Mat4) Scale(a *Mat4, v Vec3) *Mat4 {
 
 // Translate sets m to the translation of matrix a by the vector v and
 // returns m.
-func (m *Mat4) Translate(a *Mat4, v Vec3) *Mat4 {
-	*m = Mat4{
+func (m *Matrix4[T]) Translate(a *Matrix4[T], v Vector3[T]) *Matrix4[T] {
+	*m = Matrix4[T]{
 		{a[0][0], a[0][1], a[0][2], a[0][3]},
 		{a[1][0], a[1][1], a[1][2], a[1][3]},
 		{a[2][0], a[2][1], a[2][2], a[2][3]},
@@ -198,23 +514,182 @@ func (m *Mat4) Translate(a *Mat4, v Vec3) *Mat4 {
 	return m
 }
 
+// FromMat3 sets the upper-left 3x3 part of m to matrix a and the remaining
+// elements to those of the identity matrix, and returns m.
+func (m *Matrix4[T]) FromMat3(a *Matrix3[T]) *Matrix4[T] {
+	*m = Matrix4[T]{
+		{a[0][0], a[0][1], a[0][2], 0},
+		{a[1][0], a[1][1], a[1][2], 0},
+		{a[2][0], a[2][1], a[2][2], 0},
+		{0, 0, 0, 1},
+	}
+	return m
+}
+
+// FromAffine2 sets m to the 3-dimensional equivalent of the 2-dimensional
+// affine transformation a, which leaves z coordinates unchanged, and
+// returns m.
+func (m *Matrix4[T]) FromAffine2(a *AffineTransform2[T]) *Matrix4[T] {
+	*m = Matrix4[T]{
+		{a[0][0], a[0][1], 0, 0},
+		{a[1][0], a[1][1], 0, 0},
+		{0, 0, 1, 0},
+		{a[2][0], a[2][1], 0, 1},
+	}
+	return m
+}
+
 // Floats returns a pointer to the matrix elements represented as a flat
-// array of float32 numbers in row-major order. Changing an element value
-// of this array will affect m and vice versa.
-func (m *Mat4) Floats() *[16]float32 {
-	return (*[16]float32)(unsafe.Pointer(m))
+// array of numbers in the order of the indices, which is column-major for
+// column vectors (see ColumnMajor). Changing an element value of this array
+// will affect m and vice versa.
+func (m *Matrix4[T]) Floats() *[16]T {
+	return (*[16]T)(unsafe.Pointer(m))
+}
+
+// ColumnMajor returns the elements of m in column-major order for column
+// vectors, i.e. the translation is at indices 12, 13 and 14. This is the
+// layout expected by OpenGL, Vulkan, GLSL, HLSL by default and glTF, and it
+// can be uploaded with transpose set to false.
+func (m *Matrix4[T]) ColumnMajor() [16]T {
+	return *m.Floats()
+}
+
+// RowMajor returns the elements of m in row-major order for column
+// vectors, i.e. the translation is at indices 3, 7 and 11, like a matrix
+// written on paper. This is the layout of the transpose of m.
+func (m *Matrix4[T]) RowMajor() [16]T {
+	return [16]T{
+		m[0][0], m[1][0], m[2][0], m[3][0],
+		m[0][1], m[1][1], m[2][1], m[3][1],
+		m[0][2], m[1][2], m[2][2], m[3][2],
+		m[0][3], m[1][3], m[2][3], m[3][3],
+	}
+}
+
+// FromColumnMajor sets m to the matrix with the elements a in column-major
+// order as returned by ColumnMajor and returns m.
+func (m *Matrix4[T]) FromColumnMajor(a *[16]T) *Matrix4[T] {
+	*m.Floats() = *a
+	return m
+}
+
+// FromRowMajor sets m to the matrix with the elements a in row-major order
+// as returned by RowMajor and returns m.
+func (m *Matrix4[T]) FromRowMajor(a *[16]T) *Matrix4[T] {
+	*m = Matrix4[T]{
+		{a[0], a[4], a[8], a[12]},
+		{a[1], a[5], a[9], a[13]},
+		{a[2], a[6], a[10], a[14]},
+		{a[3], a[7], a[11], a[15]},
+	}
+	return m
+}
+
+// D3DClip sets m to the projection matrix a, which maps to the OpenGL clip
+// space with depths from -1 to 1, converted to the clip space of Direct3D
+// and Metal with depths from 0 to 1, and returns m.
+func (m *Matrix4[T]) D3DClip(a *Matrix4[T]) *Matrix4[T] {
+	*m = *a
+	for i := range 4 {
+		m[i][2] = (m[i][2] + m[i][3]) / 2
+	}
+	return m
+}
+
+// VulkanClip sets m to the projection matrix a, which maps to the OpenGL
+// clip space with the Y axis pointing up and depths from -1 to 1, converted
+// to the clip space of Vulkan with the Y axis pointing down and depths from
+// 0 to 1, and returns m.
+func (m *Matrix4[T]) VulkanClip(a *Matrix4[T]) *Matrix4[T] {
+	*m = *a
+	for i := range 4 {
+		m[i][1] = -m[i][1]
+		m[i][2] = (m[i][2] + m[i][3]) / 2
+	}
+	return m
 }
 
-// nearEq returns whether m and m2 are approximately equal. This relation is
+// NearEq returns whether m and m2 are approximately equal. This relation is
 // not transitive in general. The tolerance for the floating-point components
 // is ±1e-5.
-func (m *Mat4) nearEq(m2 *Mat4) bool {
+func (m *Matrix4[T]) NearEq(m2 *Matrix4[T]) bool {
+	return m.NearEqAbs(m2, epsilon)
+}
+
+// NearEqAbs returns whether m and m2 are approximately equal, with each pair
+// of elements differing by at most the absolute tolerance tol.
+// See the NearEqAbs function.
+func (m *Matrix4[T]) NearEqAbs(m2 *Matrix4[T], tol T) bool {
+	return m.nearEqFunc(m2, func(a, b T) bool { return NearEqAbs(a, b, tol) })
+}
+
+// NearEqRel returns whether m and m2 are approximately equal, with each pair
+// of elements differing by at most the relative tolerance tol.
+// See the NearEqRel function.
+func (m *Matrix4[T]) NearEqRel(m2 *Matrix4[T], tol T) bool {
+	return m.nearEqFunc(m2, func(a, b T) bool { return NearEqRel(a, b, tol) })
+}
+
+// NearEqULP returns whether m and m2 are approximately equal, with each pair
+// of elements at most ulps units in the last place apart.
+// See the NearEqULP function.
+func (m *Matrix4[T]) NearEqULP(m2 *Matrix4[T], ulps uint) bool {
+	return m.nearEqFunc(m2, func(a, b T) bool { return NearEqULP(a, b, ulps) })
+}
+
+// nearEqFunc reports whether eq holds for all pairs of corresponding
+// elements of m and m2.
+func (m *Matrix4[T]) nearEqFunc(m2 *Matrix4[T], eq func(a, b T) bool) bool {
 	for i := range 4 {
 		for j := range 4 {
-			if !nearEq(m[i][j], m2[i][j], epsilon) {
+			if !eq(m[i][j], m2[i][j]) {
 				return false
 			}
 		}
 	}
 	return true
 }
+
+// String returns a string representation of m like
+// "((1, 0, 0, 0), (0, 1, 0, 0), ...)", with the elements in the order of
+// the indices.
+func (m Matrix4[T]) String() string {
+	s := "("
+	for i := range 4 {
+		if i > 0 {
+			s += ", "
+		}
+		s += "("
+		for j := range 4 {
+			if j > 0 {
+				s += ", "
+			}
+			s += str(m[i][j])
+		}
+		s += ")"
+	}
+	return s + ")"
+}
+
+// Float32 returns m with its elements converted to float32.
+func (m *Matrix4[T]) Float32() Mat4 {
+	var r Mat4
+	for i := range 4 {
+		for j := range 4 {
+			r[i][j] = float32(m[i][j])
+		}
+	}
+	return r
+}
+
+// Float64 returns m with its elements converted to float64.
+func (m *Matrix4[T]) Float64() Mat4d {
+	var r Mat4d
+	for i := range 4 {
+		for j := range 4 {
+			r[i][j] = float64(m[i][j])
+		}
+	}
+	return r
+}
@@ -63,9 +63,9 @@ func TestMat4NearEq(t *testing.T) {
 		}, false},
 	}
 	for _, tt := range tests {
-		x := tt.a.nearEq(&tt.b)
+		x := tt.a.NearEq(&tt.b)
 		if x != tt.want {
-			t.Errorf("%v.nearEq(%v) = %v, want %v", tt.a, tt.b, x, tt.want)
+			t.Errorf("%v.NearEq(%v) = %v, want %v", tt.a, tt.b, x, tt.want)
 		}
 	}
 }
@@ -230,6 +230,75 @@ func TestMat4Perspective(t *testing.T) {
 	}
 }
 
+func TestMat4ProjectionClip(t *testing.T) {
+	depths := []struct {
+		depth     DepthRange
+		near, far float32
+	}{
+		{DepthNegOneToOne, -1, 1},
+		{DepthZeroToOne, 0, 1},
+		{DepthOneToZero, 1, 0},
+	}
+	inf := float32(math.Inf(1))
+	for _, d := range depths {
+		for _, h := range []Handedness{RightHanded, LeftHanded} {
+			c := ClipSpace{Depth: d.depth, Handedness: h}
+			s := forward[float32](h)
+			var ortho, frustum, persp, inFrustum, inPersp Mat4
+			ortho.OrthoClip(-1, 2, -1, 1, 0.5, 100, c)
+			frustum.FrustumClip(-1, 2, -1, 1, 0.5, 100, c)
+			persp.PerspectiveClip(Rad(90), 2, 0.5, 100, c)
+			inFrustum.FrustumClip(-1, 2, -1, 1, 0.5, inf, c)
+			inPersp.PerspectiveClip(Rad(90), 2, 0.5, inf, c)
+			tests := []struct {
+				name string
+				m    *Mat4
+				v    Vec3
+				want Vec3
+			}{
+				{"ortho near", &ortho, V3(2, 1, s*0.5), V3(1, 1, d.near)},
+				{"ortho far", &ortho, V3(-1, -1, s*100), V3(-1, -1, d.far)},
+				{"frustum near", &frustum, V3(2, 1, s*0.5), V3(1, 1, d.near)},
+				{"frustum far", &frustum, V3(-200, -200, s*100), V3(-1, -1, d.far)},
+				{"perspective near", &persp, V3(1, 0.5, s*0.5), V3(1, 1, d.near)},
+				{"perspective far", &persp, V3(-200, -100, s*100), V3(-1, -1, d.far)},
+				{"infinite frustum near", &inFrustum, V3(0.5, 0, s*0.5), V3(0, 0, d.near)},
+				{"infinite frustum far", &inFrustum, V3(0, 0, s*1e30), V3(-1.0/3, 0, d.far)},
+				{"infinite perspective near", &inPersp, V3(0, -0.5, s*0.5), V3(0, -1, d.near)},
+				{"infinite perspective far", &inPersp, V3(0, 0, s*1e30), V3(0, 0, d.far)},
+			}
+			for _, tt := range tests {
+				if x := tt.v.TransformPoint(tt.m); !x.NearEq(tt.want) {
+					t.Errorf("%+v %s: %s.TransformPoint(%v) = %s, want %s", c, tt.name, tt.v, tt.m, x, tt.want)
+				}
+			}
+		}
+	}
+
+	// The zero ClipSpace gives the OpenGL projections.
+	var want, m Mat4
+	want.Ortho(-1, 2, -1, 1, 0.5, 100)
+	if m.OrthoClip(-1, 2, -1, 1, 0.5, 100, ClipSpace{}); !m.NearEq(&want) {
+		t.Errorf("OrthoClip with OpenGL clip space = %v, want %v", m, want)
+	}
+	want.Frustum(-1, 2, -1, 1, 0.5, 100)
+	if m.FrustumClip(-1, 2, -1, 1, 0.5, 100, ClipSpace{}); !m.NearEq(&want) {
+		t.Errorf("FrustumClip with OpenGL clip space = %v, want %v", m, want)
+	}
+	want.Perspective(Rad(60), 1.5, 0.1, 100)
+	if m.PerspectiveClip(Rad(60), 1.5, 0.1, 100, ClipSpace{}); !m.NearEq(&want) {
+		t.Errorf("PerspectiveClip with OpenGL clip space = %v, want %v", m, want)
+	}
+	// A Direct3D projection is the same as an OpenGL projection converted
+	// with D3DClip, except for the handedness.
+	var gl Mat4
+	gl.Perspective(Rad(60), 1.5, 0.1, 100)
+	want.D3DClip(&gl)
+	if m.PerspectiveClip(Rad(60), 1.5, 0.1, 100, ClipSpace{Depth: DepthZeroToOne}); !m.NearEq(&want) {
+		t.Errorf("PerspectiveClip with depth from 0 to 1 = %v, want %v", m, want)
+	}
+}
+
 func TestMat4LookAt(t *testing.T) {
 	tests := []struct {
 		eye, center, up Vec3
@@ -257,13 +326,40 @@ func TestMat4LookAt(t *testing.T) {
 	var m Mat4
 	for _, tt := range tests {
 		m.LookAt(tt.eye, tt.center, tt.up)
-		if !tt.want.nearEq(&m) {
+		if !tt.want.NearEq(&m) {
 			t.Errorf("m.LookAt(%s, %s, %s) = %v, want %v",
 				tt.eye, tt.center, tt.up, m, tt.want)
 		}
 	}
 }
 
+func TestMat4LookAtLH(t *testing.T) {
+	var m Mat4
+	m.LookAtLH(V3(0, 0, -5), V3(0, 0, 0), V3(0, 1, 0))
+	tests := []struct {
+		v, want Vec3
+	}{
+		{V3(0, 0, 0), V3(0, 0, 5)},
+		{V3(1, 2, 0), V3(1, 2, 5)},
+		{V3(0, 0, -5), V3(0, 0, 0)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Transform(&m); !x.NearEq(tt.want) {
+			t.Errorf("%s.Transform(LookAtLH) = %s, want %s", tt.v, x, tt.want)
+		}
+	}
+	// A left-handed view of the mirrored scene is the mirrored right-handed
+	// view.
+	var rh, lh Mat4
+	c := NewBasisChange[float32](YUpRightHanded, YUpLeftHanded)
+	eye, center, up := V3(20, 80, 15), V3(15, 0, 12), V3(0, -1, 0)
+	rh.LookAt(eye, center, up)
+	lh.LookAtLH(c.Vec3(eye), c.Vec3(center), c.Vec3(up))
+	if want := c.Mat4(&rh); !lh.NearEq(&want) {
+		t.Errorf("LookAtLH(%s, %s, %s) = %v, want %v", c.Vec3(eye), c.Vec3(center), c.Vec3(up), lh, want)
+	}
+}
+
 func TestMat4Floats(t *testing.T) {
 	m := Mat4{
 		{11, 12, 13, 14},
@@ -282,6 +378,73 @@ func TestMat4Floats(t *testing.T) {
 	}
 }
 
+func TestMat4ColumnMajorRowMajor(t *testing.T) {
+	var m Mat4
+	m.Translate(&id, V3(5, 6, 7))
+	m[0][1] = 2 // shear: y += 2x
+	col := m.ColumnMajor()
+	wantCol := [16]float32{1, 2, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 5, 6, 7, 1}
+	if col != wantCol {
+		t.Errorf("%v.ColumnMajor() = %v, want %v", m, col, wantCol)
+	}
+	row := m.RowMajor()
+	wantRow := [16]float32{1, 0, 0, 5, 2, 1, 0, 6, 0, 0, 1, 7, 0, 0, 0, 1}
+	if row != wantRow {
+		t.Errorf("%v.RowMajor() = %v, want %v", m, row, wantRow)
+	}
+	// The row-major order is the one of the matrix written on paper, so
+	// multiplying its rows with a column vector gives the transformed
+	// vector.
+	v := V4(1, 1, 1, 1)
+	tv := V4(
+		row[0]*v.X+row[1]*v.Y+row[2]*v.Z+row[3]*v.W,
+		row[4]*v.X+row[5]*v.Y+row[6]*v.Z+row[7]*v.W,
+		row[8]*v.X+row[9]*v.Y+row[10]*v.Z+row[11]*v.W,
+		row[12]*v.X+row[13]*v.Y+row[14]*v.Z+row[15]*v.W,
+	)
+	if x := V3(1, 1, 1).Transform(&m); x != tv.XYZ() {
+		t.Errorf("V3(1, 1, 1).Transform(%v) = %s, want %s", m, x, tv.XYZ())
+	}
+
+	var m2 Mat4
+	if m2.FromColumnMajor(&col); m2 != m {
+		t.Errorf("FromColumnMajor(%v) = %v, want %v", col, m2, m)
+	}
+	if m2.FromRowMajor(&row); m2 != m {
+		t.Errorf("FromRowMajor(%v) = %v, want %v", row, m2, m)
+	}
+}
+
+func TestMat4Clip(t *testing.T) {
+	var p, d3d, vk Mat4
+	p.Perspective(Rad(60), 1.5, 0.5, 100)
+	d3d.D3DClip(&p)
+	vk.VulkanClip(&p)
+	tests := []struct {
+		v             Vec3
+		gl, d3d, vulk Vec3 // normalized device coordinates
+	}{
+		{V3(0, 0, -0.5), V3(0, 0, -1), V3(0, 0, 0), V3(0, 0, 0)},
+		{V3(0, 0, -100), V3(0, 0, 1), V3(0, 0, 1), V3(0, 0, 1)},
+		{V3(0, 50, -100), V3(0, 0.8660254, 1), V3(0, 0.8660254, 1), V3(0, -0.8660254, 1)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.TransformPoint(&p); !x.NearEq(tt.gl) {
+			t.Errorf("%s.TransformPoint(perspective) = %s, want %s", tt.v, x, tt.gl)
+		}
+		if x := tt.v.TransformPoint(&d3d); !x.NearEq(tt.d3d) {
+			t.Errorf("%s.TransformPoint(D3DClip(perspective)) = %s, want %s", tt.v, x, tt.d3d)
+		}
+		if x := tt.v.TransformPoint(&vk); !x.NearEq(tt.vulk) {
+			t.Errorf("%s.TransformPoint(VulkanClip(perspective)) = %s, want %s", tt.v, x, tt.vulk)
+		}
+	}
+	// The conversion works in place.
+	if p.VulkanClip(&p); p != vk {
+		t.Errorf("m.VulkanClip(&m) = %v, want %v", p, vk)
+	}
+}
+
 func TestMat4Mul(t *testing.T) {
 	tests := []struct {
 		a, b, want Mat4
@@ -323,7 +486,7 @@ func TestMat4Mul(t *testing.T) {
 	for _, tt := range tests {
 		var m Mat4
 		mp := m.Mul(&tt.a, &tt.b)
-		if !tt.want.nearEq(&m) {
+		if !tt.want.NearEq(&m) {
 			t.Errorf("%v * %v = %v, want %v", tt.a, tt.b, m, tt.want)
 		}
 		if mp != &m {
@@ -365,7 +528,7 @@ func TestMat4Rot(t *testing.T) {
 	for _, tt := range tests {
 		var m Mat4
 		mp := m.Rot(&tt.a, tt.rad, tt.axis)
-		if !tt.want.nearEq(&m) {
+		if !tt.want.NearEq(&m) {
 			t.Errorf("m.Rot(%v, %g, %s) = %v, want %v", tt.a, tt.rad, tt.axis, m, tt.want)
 		}
 		if mp != &m {
@@ -419,7 +582,7 @@ func TestMat4Scale(t *testing.T) {
 	for _, tt := range tests {
 		var m Mat4
 		mp := m.Scale(&tt.a, tt.v)
-		if !tt.want.nearEq(&m) {
+		if !tt.want.NearEq(&m) {
 			t.Errorf("m.Scale(%v, %s) = %v, want %v", tt.a, tt.v, m, tt.want)
 		}
 		if mp != &m {
@@ -477,7 +640,7 @@ func TestMat4Translate(t *testing.T) {
 	for _, tt := range tests {
 		var m Mat4
 		mp := m.Translate(&tt.a, tt.v)
-		if !tt.want.nearEq(&m) {
+		if !tt.want.NearEq(&m) {
 			t.Errorf("m.Translate(%v, %s) = %v, want %v", tt.a, tt.v, m, tt.want)
 		}
 		if mp != &m {
@@ -485,3 +648,285 @@ func TestMat4Translate(t *testing.T) {
 		}
 	}
 }
+
+func TestMat4Adj(t *testing.T) {
+	tests := []struct {
+		a, want Mat4
+	}{
+		{id, id},
+		{Mat4{
+			{2, 0, 0, 0},
+			{0, 4, 0, 0},
+			{0, 0, 5, 0},
+			{0, 0, 0, 1},
+		}, Mat4{
+			{20, 0, 0, 0},
+			{0, 10, 0, 0},
+			{0, 0, 8, 0},
+			{0, 0, 0, 40},
+		}},
+		{Mat4{
+			{1, 2, 3, 4},
+			{5, 6, 7, 8},
+			{1, 2, 3, 4},
+			{5, 6, 7, 8},
+		}, zero},
+	}
+	for _, tt := range tests {
+		var m Mat4
+		mp := m.Adj(&tt.a)
+		if !tt.want.NearEq(&m) {
+			t.Errorf("m.Adj(%v) = %v, want %v", tt.a, m, tt.want)
+		}
+		if mp != &m {
+			t.Errorf("m.Adj(...) does not return the pointer to m")
+		}
+	}
+
+	// a * adj(a) = det(a) * I
+	a := Mat4{
+		{-3, 2, 6, 5},
+		{4, 1.5, 1, 8},
+		{1, 4, 2, 4},
+		{5.25, 6, -2, 8},
+	}
+	var adj, p Mat4
+	adj.Adj(&a)
+	p.Mul(&a, &adj)
+	d := a.Det()
+	want := Mat4{
+		{d, 0, 0, 0},
+		{0, d, 0, 0},
+		{0, 0, d, 0},
+		{0, 0, 0, d},
+	}
+	for i := range 4 {
+		for j := range 4 {
+			if !nearEq(p[i][j], want[i][j], 1e-3) {
+				t.Errorf("%v * %v = %v, want %v", a, adj, p, want)
+				return
+			}
+		}
+	}
+}
+
+func TestMat4Inv(t *testing.T) {
+	tests := []struct {
+		a, want Mat4
+	}{
+		{id, id},
+		{Mat4{
+			{2, 0, 0, 0},
+			{0, 4, 0, 0},
+			{0, 0, 5, 0},
+			{0, 0, 0, 1},
+		}, Mat4{
+			{0.5, 0, 0, 0},
+			{0, 0.25, 0, 0},
+			{0, 0, 0.2, 0},
+			{0, 0, 0, 1},
+		}},
+		{Mat4{
+			{1, 0, 0, 0},
+			{0, 1, 0, 0},
+			{0, 0, 1, 0},
+			{2, -3, 4, 1},
+		}, Mat4{
+			{1, 0, 0, 0},
+			{0, 1, 0, 0},
+			{0, 0, 1, 0},
+			{-2, 3, -4, 1},
+		}},
+	}
+	for _, tt := range tests {
+		var m Mat4
+		mp := m.Inv(&tt.a)
+		if !tt.want.NearEq(&m) {
+			t.Errorf("m.Inv(%v) = %v, want %v", tt.a, m, tt.want)
+		}
+		if mp != &m {
+			t.Errorf("m.Inv(...) does not return the pointer to m")
+		}
+	}
+
+	var perspective Mat4
+	perspective.Perspective(Rad(60), 1.5, 0.1, 100)
+	for _, a := range []Mat4{
+		{
+			{-3, 2, 6, 5},
+			{4, 1.5, 1, 8},
+			{1, 4, 2, 4},
+			{5.25, 6, -2, 8},
+		},
+		perspective,
+	} {
+		var m, p Mat4
+		m.Inv(&a)
+		if p.Mul(&a, &m); !p.NearEq(&id) {
+			t.Errorf("%v * m.Inv(%[1]v) = %v, want identity", a, p)
+		}
+		// In-place inversion
+		b := a
+		if b.Inv(&b); b != m {
+			t.Errorf("in-place m.Inv(m) = %v, want %v", b, m)
+		}
+	}
+}
+
+func TestMat4TryInv(t *testing.T) {
+	tests := []struct {
+		a    Mat4
+		want bool
+	}{
+		{id, true},
+		{Mat4{
+			{-3, 2, 6, 5},
+			{4, 1.5, 1, 8},
+			{1, 4, 2, 4},
+			{5.25, 6, -2, 8},
+		}, true},
+		{zero, false},
+		{Mat4{
+			{1, 2, 3, 4},
+			{5, 6, 7, 8},
+			{1, 2, 3, 4},
+			{5, 6, 7, 8},
+		}, false},
+	}
+	for _, tt := range tests {
+		m := Mat4{{9, 9, 9, 9}}
+		before := m
+		if ok := m.TryInv(&tt.a); ok != tt.want {
+			t.Errorf("m.TryInv(%v) = %v, want %v", tt.a, ok, tt.want)
+			continue
+		}
+		if !tt.want {
+			if m != before {
+				t.Errorf("m.TryInv(%v) changed m to %v for singular matrix", tt.a, m)
+			}
+			continue
+		}
+		var inv Mat4
+		if inv.Inv(&tt.a); m != inv {
+			t.Errorf("m.TryInv(%v) set m to %v, want %v", tt.a, m, inv)
+		}
+	}
+}
+
+func TestMat4InvAffine(t *testing.T) {
+	var a Mat4
+	a.ID().Translate(&a, V3(2, -3, 4)).Rot(&a, 0.7, V3(1, 2, 3)).Scale(&a, V3(2, 0.5, -3))
+	a[1][0] = 0.3 // shear
+	var want, m Mat4
+	want.Inv(&a)
+	mp := m.InvAffine(&a)
+	if !want.NearEq(&m) {
+		t.Errorf("m.InvAffine(%v) = %v, want %v", a, m, want)
+	}
+	if mp != &m {
+		t.Errorf("m.InvAffine(...) does not return the pointer to m")
+	}
+}
+
+func TestMat4InvOrthonormal(t *testing.T) {
+	var rt, view Mat4
+	rt.ID().Translate(&rt, V3(2, -3, 4)).Rot(&rt, 0.7, V3(1, 2, 3))
+	view.LookAt(V3(20, 80, 15), V3(15, 0, 12), V3(0, 1, 0))
+	for _, a := range []Mat4{id, rt, view} {
+		var want, m Mat4
+		want.Inv(&a)
+		mp := m.InvOrthonormal(&a)
+		if !want.NearEq(&m) {
+			t.Errorf("m.InvOrthonormal(%v) = %v, want %v", a, m, want)
+		}
+		if mp != &m {
+			t.Errorf("m.InvOrthonormal(...) does not return the pointer to m")
+		}
+	}
+}
+
+func TestMat4RotQuat(t *testing.T) {
+	tests := []struct {
+		a    Mat4
+		rad  float32
+		axis Vec3
+	}{
+		{id, math.Pi / 4, V3(0, 0, 1)},
+		{Mat4{
+			{1, 0, 0, 0},
+			{0, 1, 0, 0},
+			{0, 0, 1, 0},
+			{1, 2, 3, 1},
+		}, math.Pi * 0.5, V3(1, 0, 0)},
+		{Mat4{
+			{2, 0, 1, 0},
+			{0, 3, 0, 0},
+			{1, 0, 1, 0},
+			{1, 2, 3, 1},
+		}, -1.2, V3(1, -2, 3)},
+	}
+	for _, tt := range tests {
+		var m, want Mat4
+		want.Rot(&tt.a, tt.rad, tt.axis)
+		q := QuatRot(tt.rad, tt.axis)
+		mp := m.RotQuat(&tt.a, q)
+		if !want.NearEq(&m) {
+			t.Errorf("m.RotQuat(%v, %s) = %v, want %v", tt.a, q, m, want)
+		}
+		if mp != &m {
+			t.Errorf("m.RotQuat(...) does not return the pointer to m")
+		}
+	}
+}
+
+func TestMat4Float64(t *testing.T) {
+	m := Mat4{
+		{2, 0, 0, 0},
+		{0, 4, 0, 0},
+		{0, 0, 8, 0},
+		{1, 2, 3, 1},
+	}
+	d := m.Float64()
+	var inv Mat4d
+	if !inv.TryInv(&d) {
+		t.Fatalf("Mat4d.TryInv(%v) = false, want true", d)
+	}
+	want := Mat4d{
+		{0.5, 0, 0, 0},
+		{0, 0.25, 0, 0},
+		{0, 0, 0.125, 0},
+		{-0.5, -0.5, -0.375, 1},
+	}
+	if inv != want {
+		t.Errorf("Mat4d.Inv(%v) = %v, want %v", d, inv, want)
+	}
+	if x := d.Float32(); x != m {
+		t.Errorf("%v.Float32() = %v, want %v", d, x, m)
+	}
+}
+
+func TestMat4NearEqTol(t *testing.T) {
+	a := Mat4{
+		{1e4, 0, 0, 0},
+		{0, 1e-4, 0, 0},
+		{0, 0, 1, 0},
+		{5, 6, 7, 1},
+	}
+	b := a
+	b[0][0] = 1.0001e4
+	b[1][1] = 1.0001e-4
+	if a.NearEq(&b) {
+		t.Errorf("%v.NearEq(%v) = true, want false", a, b)
+	}
+	if !a.NearEqAbs(&b, 1.5) || a.NearEqAbs(&b, 0.5) {
+		t.Errorf("%v.NearEqAbs(%v, tol) gives wrong results", a, b)
+	}
+	if !a.NearEqRel(&b, 2e-4) || a.NearEqRel(&b, 5e-5) {
+		t.Errorf("%v.NearEqRel(%v, tol) gives wrong results", a, b)
+	}
+	c := a
+	c[3][2] = math.Nextafter32(7, 8)
+	if !a.NearEqULP(&c, 1) || a.NearEqULP(&c, 0) || a.NearEqULP(&b, 100) {
+		t.Errorf("%v.NearEqULP(%v, ulps) gives wrong results", a, c)
+	}
+}
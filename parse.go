@@ -0,0 +1,296 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"fmt"
+	"strconv"
+	"unsafe"
+)
+
+// A ParseError describes a problem parsing the text representation of a
+// vector, matrix or rectangle.
+type ParseError struct {
+	Text   string // the text being parsed
+	Offset int    // byte offset into Text where the problem was detected
+	Msg    string // description of the problem
+}
+
+func (e *ParseError) Error() string {
+	return "geom: parsing " + strconv.Quote(e.Text) + ": " + e.Msg + " at offset " + strconv.Itoa(e.Offset)
+}
+
+// ParseVec2 parses a 2-dimensional vector from s. It accepts the format
+// produced by String, like "(3.25, -1.5)", as well as the common variants
+// without parentheses and with commas and/or spaces as separators, like
+// "3.25,-1.5" or "3.25 -1.5". The numbers are parsed with
+// strconv.ParseFloat. If s is not well-formed, the error is a *ParseError.
+func ParseVec2(s string) (Vec2, error) {
+	var v Vec2
+	err := v.UnmarshalText([]byte(s))
+	return v, err
+}
+
+// ParseVec3 parses a 3-dimensional vector from s. It accepts the format
+// produced by String, like "(1, 2, 3)", and the same variants as ParseVec2,
+// like "1,2,3" or "1 2 3". If s is not well-formed, the error is a
+// *ParseError.
+func ParseVec3(s string) (Vec3, error) {
+	var v Vec3
+	err := v.UnmarshalText([]byte(s))
+	return v, err
+}
+
+// ParseMat4 parses a 4x4 matrix from s. It accepts the format produced by
+// String, i.e. four parenthesized tuples of four numbers enclosed in
+// parentheses, as well as 16 numbers without the inner parentheses, with
+// the same separators as ParseVec2. The elements are in the order of the
+// indices in both cases. If s is not well-formed, the error is a
+// *ParseError.
+func ParseMat4(s string) (Mat4, error) {
+	var m Mat4
+	err := m.UnmarshalText([]byte(s))
+	return m, err
+}
+
+// ParseRect parses a rectangle from s. It accepts the format produced by
+// String, like "(0, 0)-(4, 3)", as well as the four numbers x0, y0, x1, y1
+// in one tuple with the same variants as ParseVec2, like "0,0,4,3" or
+// "(0 0 4 3)". If s is not well-formed, the error is a *ParseError.
+func ParseRect(s string) (Rectangle, error) {
+	var r Rectangle
+	err := r.UnmarshalText([]byte(s))
+	return r, err
+}
+
+// Set implements the flag.Value interface. It parses s like ParseVec2, so
+// that a vector can be given on the command line as e.g. -pos 1,2.
+func (v *Vector2[T]) Set(s string) error {
+	return v.UnmarshalText([]byte(s))
+}
+
+// Set implements the flag.Value interface. It parses s like ParseVec3, so
+// that a vector can be given on the command line as e.g. -pos 1,2,3.
+func (v *Vector3[T]) Set(s string) error {
+	return v.UnmarshalText([]byte(s))
+}
+
+// Set implements the flag.Value interface. It parses s like ParseMat4.
+func (m *Matrix4[T]) Set(s string) error {
+	return m.UnmarshalText([]byte(s))
+}
+
+// Set implements the flag.Value interface. It parses s like ParseRect, so
+// that a rectangle can be given on the command line as e.g.
+// -viewport 0,0,640,480.
+func (r *Rectangle) Set(s string) error {
+	return r.UnmarshalText([]byte(s))
+}
+
+// textParser parses the text representations of the types of this package.
+type textParser struct {
+	s   string
+	pos int
+}
+
+// skipSpace advances the position past any spaces.
+func (p *textParser) skipSpace() {
+	for p.pos < len(p.s) && isSpace(p.s[p.pos]) {
+		p.pos++
+	}
+}
+
+// peek returns the next byte after optional spaces, or 0 at the end of the
+// text.
+func (p *textParser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.s) {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+// consume consumes the byte c after optional spaces and reports whether it
+// was present.
+func (p *textParser) consume(c byte) bool {
+	if p.peek() == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// expect consumes the byte c after optional spaces or returns an error.
+func (p *textParser) expect(c byte) error {
+	if !p.consume(c) {
+		return p.errorf("expected %q, found %s", c, p.found())
+	}
+	return nil
+}
+
+// end returns an error if there is any text left except for spaces.
+func (p *textParser) end() error {
+	if p.peek() != 0 {
+		return p.errorf("unexpected %s after end of value", p.found())
+	}
+	return nil
+}
+
+// found describes the next byte for error messages.
+func (p *textParser) found() string {
+	if p.peek() == 0 {
+		return "end of text"
+	}
+	return strconv.QuoteRune(rune(p.s[p.pos]))
+}
+
+// number consumes a floating-point number of the given bit size after
+// optional spaces.
+func (p *textParser) number(bitSize int) (float64, error) {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.s) && !isSpace(p.s[p.pos]) && !isDelim(p.s[p.pos]) {
+		p.pos++
+	}
+	tok := p.s[start:p.pos]
+	if tok == "" {
+		return 0, p.errorf("expected number, found %s", p.found())
+	}
+	f, err := strconv.ParseFloat(tok, bitSize)
+	if err != nil {
+		p.pos = start
+		if err.(*strconv.NumError).Err == strconv.ErrRange {
+			return 0, p.errorf("number %q out of range", tok)
+		}
+		return 0, p.errorf("invalid number %q", tok)
+	}
+	return f, nil
+}
+
+// errorf returns a *ParseError for the current position.
+func (p *textParser) errorf(format string, args ...any) error {
+	return &ParseError{Text: p.s, Offset: p.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// isSpace reports whether c is a white space character.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+// isDelim reports whether c is a delimiter that terminates a number.
+func isDelim(c byte) bool {
+	return c == ',' || c == '(' || c == ')'
+}
+
+// list parses a sequence of at most len(dst) numbers separated by commas
+// and/or spaces into dst and returns the count. If paren is true the
+// opening parenthesis has already been consumed and the closing one is
+// expected at the end of the sequence.
+func list[T Float](p *textParser, dst []T, paren bool) (int, error) {
+	bitSize := int(unsafe.Sizeof(dst[0])) * 8
+	n := 0
+	for {
+		c := p.peek()
+		if n > 0 {
+			if c == ',' {
+				p.pos++
+			} else if c == 0 || c == ')' || c == '(' {
+				break
+			}
+		}
+		start := p.pos
+		f, err := p.number(bitSize)
+		if err != nil {
+			return n, err
+		}
+		if n == len(dst) {
+			p.pos = start
+			p.skipSpace()
+			return n, p.errorf("too many numbers, want %d", len(dst))
+		}
+		dst[n] = T(f)
+		n++
+	}
+	if paren {
+		return n, p.expect(')')
+	}
+	return n, nil
+}
+
+// fullList parses exactly len(dst) numbers like list.
+func fullList[T Float](p *textParser, dst []T, paren bool) error {
+	n, err := list(p, dst, paren)
+	if err != nil {
+		return err
+	}
+	if n < len(dst) {
+		return p.errorf("expected %d numbers, found %d", len(dst), n)
+	}
+	return nil
+}
+
+// parseVector parses s as a tuple of len(dst) numbers into dst, with or
+// without parentheses.
+func parseVector[T Float](s string, dst []T) error {
+	p := &textParser{s: s}
+	if err := fullList(p, dst, p.consume('(')); err != nil {
+		return err
+	}
+	return p.end()
+}
+
+// parseMatrix parses s as an n x n matrix into dst, either as a tuple of n
+// parenthesized tuples of n numbers or as a flat tuple of n*n numbers.
+func parseMatrix[T Float](s string, dst []T, n int) error {
+	p := &textParser{s: s}
+	paren := p.consume('(')
+	if p.peek() != '(' {
+		if err := fullList(p, dst, paren); err != nil {
+			return err
+		}
+		return p.end()
+	}
+	for i := range n {
+		if i > 0 {
+			p.consume(',')
+		}
+		if err := p.expect('('); err != nil {
+			return err
+		}
+		if err := fullList(p, dst[i*n:(i+1)*n], true); err != nil {
+			return err
+		}
+	}
+	if paren {
+		if err := p.expect(')'); err != nil {
+			return err
+		}
+	}
+	return p.end()
+}
+
+// parseRange parses s into dst either as two tuples of len(dst)/2 numbers
+// separated by a hyphen like "(x0, y0)-(x1, y1)", or as a single tuple of
+// len(dst) numbers.
+func parseRange[T Float](s string, dst []T) error {
+	p := &textParser{s: s}
+	paren := p.consume('(')
+	n, err := list(p, dst, paren)
+	if err != nil {
+		return err
+	}
+	half := len(dst) / 2
+	if paren && n == half && p.consume('-') {
+		if err := p.expect('('); err != nil {
+			return err
+		}
+		if err := fullList(p, dst[half:], true); err != nil {
+			return err
+		}
+	} else if n < len(dst) {
+		return p.errorf("expected %d or twice %d numbers, found %d", len(dst), half, n)
+	}
+	return p.end()
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"testing"
+)
+
+func TestParseVec2(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Vec2
+	}{
+		{"(3.25, -1.5)", V2(3.25, -1.5)},
+		{"3.25,-1.5", V2(3.25, -1.5)},
+		{"3.25 -1.5", V2(3.25, -1.5)},
+		{" 3.25 , -1.5 ", V2(3.25, -1.5)},
+		{"(1e3 2)", V2(1000, 2)},
+	}
+	for _, tt := range tests {
+		if v, err := ParseVec2(tt.s); err != nil || v != tt.want {
+			t.Errorf("ParseVec2(%q) = %s, %v, want %s, nil", tt.s, v, err, tt.want)
+		}
+	}
+}
+
+func TestParseVec3(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Vec3
+	}{
+		{"(1, 2, 3)", V3(1, 2, 3)},
+		{"1,2,3", V3(1, 2, 3)},
+		{"1 2 3", V3(1, 2, 3)},
+		{"-1\t-2\t-3", V3(-1, -2, -3)},
+	}
+	for _, tt := range tests {
+		if v, err := ParseVec3(tt.s); err != nil || v != tt.want {
+			t.Errorf("ParseVec3(%q) = %s, %v, want %s, nil", tt.s, v, err, tt.want)
+		}
+	}
+}
+
+func TestParseMat4(t *testing.T) {
+	want := Mat4{
+		{1, 0, 0, 0},
+		{0, 2, 0, 0},
+		{0, 0, 3, 0},
+		{4, 5, 6, 1},
+	}
+	tests := []string{
+		"((1, 0, 0, 0), (0, 2, 0, 0), (0, 0, 3, 0), (4, 5, 6, 1))",
+		"((1 0 0 0) (0 2 0 0) (0 0 3 0) (4 5 6 1))",
+		"(1, 0, 0, 0, 0, 2, 0, 0, 0, 0, 3, 0, 4, 5, 6, 1)",
+		"1 0 0 0 0 2 0 0 0 0 3 0 4 5 6 1",
+	}
+	for _, s := range tests {
+		if m, err := ParseMat4(s); err != nil || m != want {
+			t.Errorf("ParseMat4(%q) = %v, %v, want %v, nil", s, m, err, want)
+		}
+	}
+}
+
+func TestParseRect(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Rectangle
+	}{
+		{"(0, -1)-(4, 3.5)", Rect(0, -1, 4, 3.5)},
+		{"(0, -1) - (-4, 3.5)", Rect(0, -1, -4, 3.5)},
+		{"0,-1,4,3.5", Rect(0, -1, 4, 3.5)},
+		{"(0 -1 4 3.5)", Rect(0, -1, 4, 3.5)},
+	}
+	for _, tt := range tests {
+		if r, err := ParseRect(tt.s); err != nil || r != tt.want {
+			t.Errorf("ParseRect(%q) = %s, %v, want %s, nil", tt.s, r, err, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		parse  func(string) error
+		s      string
+		offset int
+		msg    string
+	}{
+		{parseVec2Err, "", 0, "expected number, found end of text"},
+		{parseVec2Err, "(1, x)", 4, `invalid number "x"`},
+		{parseVec2Err, "(1, 2", 5, `expected ')', found end of text`},
+		{parseVec2Err, "(1, 2, 3)", 7, "too many numbers, want 2"},
+		{parseVec2Err, "1", 1, "expected 2 numbers, found 1"},
+		{parseVec2Err, "1,2)", 3, `unexpected ')' after end of value`},
+		{parseVec2Err, "1,,2", 2, `expected number, found ','`},
+		{parseVec2Err, "(1, 1e39)", 4, `number "1e39" out of range`},
+		{parseVec3Err, "(1, 2)", 6, "expected 3 numbers, found 2"},
+		{parseMat4Err, "((1, 0, 0, 0), (0, 1, 0, 0))", 27, `expected '(', found ')'`},
+		{parseMat4Err, "1 2 3", 5, "expected 16 numbers, found 3"},
+		{parseRectErr, "(1, 2)-3", 7, `expected '(', found '3'`},
+		{parseRectErr, "(1, 2, 3)", 9, "expected 4 or twice 2 numbers, found 3"},
+	}
+	for _, tt := range tests {
+		err := tt.parse(tt.s)
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("parsing %q: got error %v, want *ParseError", tt.s, err)
+			continue
+		}
+		if perr.Text != tt.s || perr.Offset != tt.offset || perr.Msg != tt.msg {
+			t.Errorf("parsing %q: got %+v, want offset %d, message %q", tt.s, *perr, tt.offset, tt.msg)
+		}
+	}
+	err := &ParseError{Text: "(1, x)", Offset: 4, Msg: `invalid number "x"`}
+	want := `geom: parsing "(1, x)": invalid number "x" at offset 4`
+	if s := err.Error(); s != want {
+		t.Errorf("Error() = %q, want %q", s, want)
+	}
+}
+
+func parseVec2Err(s string) error { _, err := ParseVec2(s); return err }
+func parseVec3Err(s string) error { _, err := ParseVec3(s); return err }
+func parseMat4Err(s string) error { _, err := ParseMat4(s); return err }
+func parseRectErr(s string) error { _, err := ParseRect(s); return err }
+
+func TestFlagValue(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	var (
+		pos      Vec3
+		dir      = V2(1, 0)
+		viewport Rectangle
+		m        Mat4
+	)
+	fs.Var(&pos, "pos", "position")
+	fs.Var(&dir, "dir", "direction")
+	fs.Var(&viewport, "viewport", "viewport")
+	fs.Var(&m, "m", "matrix")
+	err := fs.Parse([]string{"-pos", "1,2,3", "-viewport", "0,0,640,480", "-m", "1 0 0 0 0 1 0 0 0 0 1 0 5 6 7 1"})
+	if err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	if pos != V3(1, 2, 3) {
+		t.Errorf("-pos = %s, want (1, 2, 3)", pos)
+	}
+	if dir != V2(1, 0) {
+		t.Errorf("-dir = %s, want default (1, 0)", dir)
+	}
+	if viewport != Rect(0, 0, 640, 480) {
+		t.Errorf("-viewport = %s, want (0, 0)-(640, 480)", viewport)
+	}
+	if m[3] != [4]float32{5, 6, 7, 1} {
+		t.Errorf("-m = %v, want translation (5, 6, 7)", m)
+	}
+	if err := fs.Parse([]string{"-pos", "1,2"}); err == nil {
+		t.Errorf("Parse(-pos 1,2): expected error")
+	}
+	if s := fs.Lookup("dir").DefValue; s != "(1, 0)" {
+		t.Errorf("default value of -dir = %q, want %q", s, "(1, 0)")
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"math"
+	"strconv"
+)
+
+// A Plane represents a plane in 3-dimensional euclidean space. It contains
+// the points p with Normal·p + D = 0. The Normal points to the positive
+// half-space. If Normal is a unit vector, -D is the signed distance of the
+// plane from the origin along the normal.
+type Plane struct {
+	Normal Vec3
+	D      float32
+}
+
+// PlaneFromPointNormal returns the plane through point pt with normal n.
+func PlaneFromPointNormal(pt, n Vec3) Plane {
+	return Plane{Normal: n, D: -n.Dot(pt)}
+}
+
+// PlaneFromPoints returns the plane through the points a, b and c. The
+// normal is the normalized (b-a)×(c-a), i.e. it points to the side from
+// which the points appear in counterclockwise order.
+func PlaneFromPoints(a, b, c Vec3) Plane {
+	return PlaneFromPointNormal(a, b.Sub(a).Cross(c.Sub(a)).Norm())
+}
+
+// Norm returns the plane with the normal normalized to unit length. It
+// contains the same points as p.
+func (p Plane) Norm() Plane {
+	l := p.Normal.Len()
+	return Plane{Normal: Vec3{p.Normal.X / l, p.Normal.Y / l, p.Normal.Z / l}, D: p.D / l}
+}
+
+// Dist returns the signed distance of point pt from the plane. It is
+// positive on the side the normal points to. The result is only a true
+// distance if the plane's normal is normalized; otherwise it is scaled by
+// the length of the normal.
+func (p Plane) Dist(pt Vec3) float32 {
+	return p.Normal.Dot(pt) + p.D
+}
+
+// Project returns the orthogonal projection of point pt onto the plane,
+// i.e. the point on the plane closest to pt.
+func (p Plane) Project(pt Vec3) Vec3 {
+	return pt.Sub(p.Normal.Mul(p.Dist(pt) / p.Normal.SqLen()))
+}
+
+// A Containment describes the result of classifying a volume against a
+// frustum.
+type Containment int
+
+const (
+	// Outside means the volume is entirely outside.
+	Outside Containment = iota
+	// Intersecting means the volume is partly inside and partly outside.
+	Intersecting
+	// Inside means the volume is entirely inside.
+	Inside
+)
+
+// String returns the name of the containment value like "Inside".
+func (c Containment) String() string {
+	switch c {
+	case Outside:
+		return "Outside"
+	case Intersecting:
+		return "Intersecting"
+	case Inside:
+		return "Inside"
+	}
+	return "Containment(" + strconv.Itoa(int(c)) + ")"
+}
+
+// A Frustum represents a viewing volume bounded by six planes with normals
+// pointing inwards, in the order left, right, bottom, top, near, far.
+type Frustum [6]Plane
+
+// FrustumOf extracts the frustum planes from the combined view-projection
+// matrix m, as described by Gribb and Hartmann. If m is only a projection
+// matrix, the planes are in view space; if m is the product of projection
+// and view matrix, they are in world space. The planes are normalized.
+// FrustumOf expects the clip space of OpenGL, as produced by Perspective,
+// Frustum and Ortho; use FrustumOfClip for other depth ranges.
+func FrustumOf(m *Mat4) Frustum {
+	return FrustumOfClip(m, ClipSpace{Depth: DepthNegOneToOne})
+}
+
+// FrustumOfClip is like FrustumOf for a projection into the clip space c,
+// as produced by PerspectiveClip, FrustumClip and OrthoClip. Only the depth
+// range of c is relevant. If the far plane is infinite, the far plane of
+// the frustum has a zero normal and an infinite D, so that every point is
+// in front of it.
+func FrustumOfClip(m *Mat4, c ClipSpace) Frustum {
+	row := func(i int) Vec4 {
+		return Vec4{m[0][i], m[1][i], m[2][i], m[3][i]}
+	}
+	r0, r1, r2, r3 := row(0), row(1), row(2), row(3)
+	plane := func(v Vec4) Plane {
+		if v.XYZ() == (Vec3{}) {
+			return Plane{D: float32(math.Inf(1))}
+		}
+		return Plane{Normal: v.XYZ(), D: v.W}.Norm()
+	}
+	// The clip space depth z satisfies near <= z <= far with
+	// near, far = -w, w for DepthNegOneToOne, 0, w for DepthZeroToOne and
+	// w, 0 for DepthOneToZero.
+	near, far := r3.Add(r2), r3.Sub(r2)
+	switch c.Depth {
+	case DepthZeroToOne:
+		near = r2
+	case DepthOneToZero:
+		near, far = r3.Sub(r2), r2
+	}
+	return Frustum{
+		plane(r3.Add(r0)),
+		plane(r3.Sub(r0)),
+		plane(r3.Add(r1)),
+		plane(r3.Sub(r1)),
+		plane(near),
+		plane(far),
+	}
+}
+
+// ContainsPoint reports whether point pt is inside the frustum or on its
+// boundary.
+func (f *Frustum) ContainsPoint(pt Vec3) bool {
+	for _, p := range f {
+		if p.Dist(pt) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// ClassifySphere classifies the sphere with the given center and radius as
+// Inside, Outside or Intersecting the frustum. The classification is
+// conservative: a sphere near an edge of the frustum may be reported as
+// Intersecting although it is outside.
+func (f *Frustum) ClassifySphere(center Vec3, radius float32) Containment {
+	c := Inside
+	for _, p := range f {
+		d := p.Dist(center)
+		if d < -radius {
+			return Outside
+		}
+		if d < radius {
+			c = Intersecting
+		}
+	}
+	return c
+}
+
+// ClassifyBox classifies box b as Inside, Outside or Intersecting the
+// frustum. The classification is conservative: a box near an edge of the
+// frustum may be reported as Intersecting although it is outside.
+func (f *Frustum) ClassifyBox(b Box) Containment {
+	c := Inside
+	for _, p := range f {
+		// The corner farthest along the normal (positive vertex) and
+		// the corner farthest against it (negative vertex).
+		pos, neg := b.Max, b.Min
+		if p.Normal.X < 0 {
+			pos.X, neg.X = b.Min.X, b.Max.X
+		}
+		if p.Normal.Y < 0 {
+			pos.Y, neg.Y = b.Min.Y, b.Max.Y
+		}
+		if p.Normal.Z < 0 {
+			pos.Z, neg.Z = b.Min.Z, b.Max.Z
+		}
+		if p.Dist(pos) < 0 {
+			return Outside
+		}
+		if p.Dist(neg) < 0 {
+			c = Intersecting
+		}
+	}
+	return c
+}
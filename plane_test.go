@@ -0,0 +1,256 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPlaneFromPoints(t *testing.T) {
+	tests := []struct {
+		a, b, c Vec3
+		want    Plane
+	}{
+		{V3(0, 0, 0), V3(1, 0, 0), V3(0, 1, 0), Plane{V3UnitZ, 0}},
+		{V3(0, 2, 0), V3(0, 2, 1), V3(1, 2, 0), Plane{V3UnitY, -2}},
+		{V3(1, 0, 0), V3(0, 1, 0), V3(0, 0, 1), Plane{V3(1, 1, 1).Norm(), -0.57735026}},
+	}
+	for _, tt := range tests {
+		if p := PlaneFromPoints(tt.a, tt.b, tt.c); !planeNearEq(p, tt.want) {
+			t.Errorf("PlaneFromPoints(%s, %s, %s) = %v, want %v", tt.a, tt.b, tt.c, p, tt.want)
+		}
+	}
+}
+
+func TestPlaneFromPointNormal(t *testing.T) {
+	tests := []struct {
+		pt, n Vec3
+		want  Plane
+	}{
+		{V3(0, 0, 0), V3UnitZ, Plane{V3UnitZ, 0}},
+		{V3(5, 3, -1), V3UnitY, Plane{V3UnitY, -3}},
+		{V3(1, 1, 1), V3(0, 0, -2), Plane{V3(0, 0, -2), 2}},
+	}
+	for _, tt := range tests {
+		if p := PlaneFromPointNormal(tt.pt, tt.n); !planeNearEq(p, tt.want) {
+			t.Errorf("PlaneFromPointNormal(%s, %s) = %v, want %v", tt.pt, tt.n, p, tt.want)
+		}
+	}
+}
+
+func TestPlaneNorm(t *testing.T) {
+	tests := []struct {
+		p, want Plane
+	}{
+		{Plane{V3UnitZ, 3}, Plane{V3UnitZ, 3}},
+		{Plane{V3(0, 0, -2), 2}, Plane{V3(0, 0, -1), 1}},
+		{Plane{V3(3, 0, 4), 10}, Plane{V3(0.6, 0, 0.8), 2}},
+	}
+	for _, tt := range tests {
+		if x := tt.p.Norm(); !planeNearEq(x, tt.want) {
+			t.Errorf("%v.Norm() = %v, want %v", tt.p, x, tt.want)
+		}
+	}
+}
+
+func TestPlaneDistProject(t *testing.T) {
+	tests := []struct {
+		p    Plane
+		pt   Vec3
+		dist float32
+		proj Vec3
+	}{
+		{Plane{V3UnitY, -2}, V3(1, 5, 3), 3, V3(1, 2, 3)},
+		{Plane{V3UnitY, -2}, V3(1, -1, 3), -3, V3(1, 2, 3)},
+		{Plane{V3UnitY, -2}, V3(1, 2, 3), 0, V3(1, 2, 3)},
+		{Plane{V3(0.6, 0, 0.8), 0}, V3(3, 1, 4), 5, V3(0, 1, 0)},
+		{Plane{V3(0, 0, 2), -2}, V3(1, 1, 3), 4, V3(1, 1, 1)},
+	}
+	for _, tt := range tests {
+		if x := tt.p.Dist(tt.pt); !nearEq(x, tt.dist, epsilon) {
+			t.Errorf("%v.Dist(%s) = %g, want %g", tt.p, tt.pt, x, tt.dist)
+		}
+		if x := tt.p.Project(tt.pt); !x.NearEq(tt.proj) {
+			t.Errorf("%v.Project(%s) = %s, want %s", tt.p, tt.pt, x, tt.proj)
+		}
+	}
+}
+
+func TestFrustumOf(t *testing.T) {
+	var ortho Mat4
+	ortho.Ortho(-2, 2, -1, 1, 1, 10)
+	f := FrustumOf(&ortho)
+	want := Frustum{
+		{V3(1, 0, 0), 2},
+		{V3(-1, 0, 0), 2},
+		{V3(0, 1, 0), 1},
+		{V3(0, -1, 0), 1},
+		{V3(0, 0, -1), -1},
+		{V3(0, 0, 1), 10},
+	}
+	for i := range f {
+		if !planeNearEq(f[i], want[i]) {
+			t.Errorf("FrustumOf(%v)[%d] = %v, want %v", ortho, i, f[i], want[i])
+		}
+	}
+
+	var persp Mat4
+	persp.Perspective(math.Pi/2, 1, 1, 10)
+	f = FrustumOf(&persp)
+	s := float32(math.Sqrt2 / 2)
+	want = Frustum{
+		{V3(s, 0, -s), 0},
+		{V3(-s, 0, -s), 0},
+		{V3(0, s, -s), 0},
+		{V3(0, -s, -s), 0},
+		{V3(0, 0, -1), -1},
+		{V3(0, 0, 1), 10},
+	}
+	for i := range f {
+		if !planeNearEq(f[i], want[i]) {
+			t.Errorf("FrustumOf(%v)[%d] = %v, want %v", persp, i, f[i], want[i])
+		}
+	}
+}
+
+func TestFrustumOfClip(t *testing.T) {
+	inf := float32(math.Inf(1))
+	tests := []struct {
+		name string
+		c    ClipSpace
+		far  float32 // 0 for an orthographic projection with far=10
+	}{
+		{"ortho", ClipSpace{DepthNegOneToOne, RightHanded}, 0},
+		{"ortho", ClipSpace{DepthZeroToOne, RightHanded}, 0},
+		{"ortho", ClipSpace{DepthOneToZero, LeftHanded}, 0},
+		{"perspective", ClipSpace{DepthNegOneToOne, RightHanded}, 10},
+		{"perspective", ClipSpace{DepthZeroToOne, RightHanded}, 10},
+		{"perspective", ClipSpace{DepthZeroToOne, LeftHanded}, 10},
+		{"perspective", ClipSpace{DepthOneToZero, RightHanded}, 10},
+		{"perspective", ClipSpace{DepthNegOneToOne, RightHanded}, inf},
+		{"perspective", ClipSpace{DepthZeroToOne, LeftHanded}, inf},
+		{"perspective", ClipSpace{DepthOneToZero, RightHanded}, inf},
+	}
+	for _, tt := range tests {
+		var proj Mat4
+		if tt.far == 0 {
+			proj.OrthoClip(-2, 2, -2, 2, 1, 10, tt.c)
+		} else {
+			proj.PerspectiveClip(math.Pi/2, 1, 1, tt.far, tt.c)
+		}
+		f := FrustumOfClip(&proj, tt.c)
+		if tt.far == inf && f[5] != (Plane{D: inf}) {
+			t.Errorf("%s %+v far=%g: far plane %v, want %v",
+				tt.name, tt.c, tt.far, f[5], Plane{D: inf})
+		}
+		// Distances along the viewing direction
+		dists := []struct {
+			d    float32
+			want bool
+		}{
+			{0.9, false},
+			{1.1, true},
+			{9.9, true},
+			{10.1, tt.far == inf},
+			{1e6, tt.far == inf},
+		}
+		fwd := float32(-1)
+		if tt.c.Handedness == LeftHanded {
+			fwd = 1
+		}
+		for _, d := range dists {
+			pt := V3(0, 0, fwd*d.d)
+			if x := f.ContainsPoint(pt); x != d.want {
+				t.Errorf("%s %+v far=%g: f.ContainsPoint(%s) = %v, want %v",
+					tt.name, tt.c, tt.far, pt, x, d.want)
+			}
+		}
+		if x := f.ClassifySphere(V3(0, 0, fwd*5), 0.5); x != Inside {
+			t.Errorf("%s %+v far=%g: f.ClassifySphere = %v, want Inside",
+				tt.name, tt.c, tt.far, x)
+		}
+	}
+}
+
+func TestFrustumClassify(t *testing.T) {
+	var view, proj, vp Mat4
+	view.LookAt(V3(0, 0, 5), V3(0, 0, 0), V3UnitY)
+	proj.Perspective(math.Pi/2, 1, 1, 10)
+	vp.Mul(&proj, &view)
+	f := FrustumOf(&vp)
+
+	points := []struct {
+		pt   Vec3
+		want bool
+	}{
+		{V3(0, 0, 0), true},
+		{V3(0, 0, 4.5), false},
+		{V3(0, 0, -6), false},
+		{V3(3, 0, 0), true},
+		{V3(6, 0, 0), false},
+		{V3(0, -4, 0), true},
+	}
+	for _, tt := range points {
+		if x := f.ContainsPoint(tt.pt); x != tt.want {
+			t.Errorf("f.ContainsPoint(%s) = %v, want %v", tt.pt, x, tt.want)
+		}
+	}
+
+	spheres := []struct {
+		center Vec3
+		radius float32
+		want   Containment
+	}{
+		{V3(0, 0, 0), 1, Inside},
+		{V3(0, 0, 0), 5, Intersecting},
+		{V3(0, 0, -5), 1, Intersecting},
+		{V3(0, 0, 10), 1, Outside},
+		{V3(20, 0, 0), 2, Outside},
+		{V3(5, 0, 0), 1, Intersecting},
+	}
+	for _, tt := range spheres {
+		if x := f.ClassifySphere(tt.center, tt.radius); x != tt.want {
+			t.Errorf("f.ClassifySphere(%s, %g) = %v, want %v", tt.center, tt.radius, x, tt.want)
+		}
+	}
+
+	boxes := []struct {
+		b    Box
+		want Containment
+	}{
+		{Box{V3(-1, -1, -1), V3(1, 1, 1)}, Inside},
+		{Box{V3(-10, -1, -1), V3(10, 1, 1)}, Intersecting},
+		{Box{V3(-1, -1, 4.5), V3(1, 1, 6)}, Outside},
+		{Box{V3(20, 20, -1), V3(21, 21, 1)}, Outside},
+		{Box{V3(-1, -1, 3), V3(1, 1, 6)}, Intersecting},
+	}
+	for _, tt := range boxes {
+		if x := f.ClassifyBox(tt.b); x != tt.want {
+			t.Errorf("f.ClassifyBox(%v) = %v, want %v", tt.b, x, tt.want)
+		}
+	}
+}
+
+func TestContainmentString(t *testing.T) {
+	tests := []struct {
+		c    Containment
+		want string
+	}{
+		{Outside, "Outside"},
+		{Intersecting, "Intersecting"},
+		{Inside, "Inside"},
+		{Containment(7), "Containment(7)"},
+	}
+	for _, tt := range tests {
+		if s := tt.c.String(); s != tt.want {
+			t.Errorf("Containment(%d).String() = %q, want %q", int(tt.c), s, tt.want)
+		}
+	}
+}
+
+func planeNearEq(a, b Plane) bool {
+	return a.Normal.NearEq(b.Normal) && nearEq(a.D, b.D, epsilon)
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+// Project maps the object coordinates obj to window coordinates, given the
+// model, view and projection matrices and the viewport rectangle in window
+// coordinates. Like gluProject, the window's y axis points up and the
+// resulting Z coordinate is the depth in the range [0,1] from the near to
+// the far clipping plane.
+func Project(obj Vec3, model, view, proj *Mat4, viewport Rectangle) Vec3 {
+	var mvp Mat4
+	mvp.Mul(proj, mvp.Mul(view, model))
+	ndc := obj.TransformPoint(&mvp)
+	size := viewport.Size()
+	return Vec3{
+		viewport.Min.X + size.W*(ndc.X+1)/2,
+		viewport.Min.Y + size.H*(ndc.Y+1)/2,
+		(ndc.Z + 1) / 2,
+	}
+}
+
+// Unproject maps the window coordinates win to object coordinates, given the
+// model, view and projection matrices and the viewport rectangle in window
+// coordinates. It is the inverse of Project. The Z coordinate of win is the
+// depth in the range [0,1] from the near to the far clipping plane.
+// Unproject reports false if the combined matrix is not invertible.
+func Unproject(win Vec3, model, view, proj *Mat4, viewport Rectangle) (obj Vec3, ok bool) {
+	var inv Mat4
+	if !inv.TryInv(inv.Mul(proj, inv.Mul(view, model))) {
+		return Vec3{}, false
+	}
+	return unproject(win, &inv, viewport), true
+}
+
+// PickRay returns the world space ray through the window coordinates x and
+// y, given the view and projection matrices and the viewport rectangle in
+// window coordinates. The ray starts on the near clipping plane and its
+// direction is normalized. Like for Unproject, the window's y axis points up;
+// for window systems with a downward y axis pass viewport.Max.Y - y.
+// PickRay reports false if the combined matrix is not invertible.
+func PickRay(x, y float32, view, proj *Mat4, viewport Rectangle) (origin, dir Vec3, ok bool) {
+	var inv Mat4
+	if !inv.TryInv(inv.Mul(proj, view)) {
+		return Vec3{}, Vec3{}, false
+	}
+	near := unproject(Vec3{x, y, 0}, &inv, viewport)
+	far := unproject(Vec3{x, y, 1}, &inv, viewport)
+	return near, far.Sub(near).Norm(), true
+}
+
+// unproject maps the window coordinates win to object coordinates, given
+// the inverse of the combined model-view-projection matrix.
+func unproject(win Vec3, inv *Mat4, viewport Rectangle) Vec3 {
+	size := viewport.Size()
+	ndc := Vec3{
+		2*(win.X-viewport.Min.X)/size.W - 1,
+		2*(win.Y-viewport.Min.Y)/size.H - 1,
+		2*win.Z - 1,
+	}
+	return ndc.TransformPoint(inv)
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestProject(t *testing.T) {
+	var ortho, persp Mat4
+	ortho.Ortho(-10, 10, -5, 5, 1, 11)
+	persp.Perspective(math.Pi/2, 2, 1, 3)
+	viewport := Rect(0, 0, 640, 480)
+
+	tests := []struct {
+		obj  Vec3
+		proj *Mat4
+		want Vec3
+	}{
+		{V3(0, 0, -1), &ortho, V3(320, 240, 0)},
+		{V3(-10, -5, -1), &ortho, V3(0, 0, 0)},
+		{V3(10, 5, -11), &ortho, V3(640, 480, 1)},
+		{V3(5, 0, -6), &ortho, V3(480, 240, 0.5)},
+		{V3(0, 0, -1), &persp, V3(320, 240, 0)},
+		{V3(0, 0, -3), &persp, V3(320, 240, 1)},
+		{V3(2, 1, -1), &persp, V3(640, 480, 0)},
+	}
+	for _, tt := range tests {
+		if x := Project(tt.obj, &id, &id, tt.proj, viewport); !x.NearEq(tt.want) {
+			t.Errorf("Project(%s, id, id, %v, %v) = %s, want %s",
+				tt.obj, *tt.proj, viewport, x, tt.want)
+		}
+	}
+
+	// Viewport offset and model/view transforms
+	var model, view Mat4
+	model.ID().Translate(&model, V3(0, 0, -5))
+	view.LookAt(V3(0, 0, 4), V3(0, 0, 0), V3UnitY)
+	obj := V3(5, 0, 0)
+	want := V3(100+480, 50+240, 0.8)
+	if x := Project(obj, &model, &view, &ortho, Rect(100, 50, 740, 530)); !x.NearEq(want) {
+		t.Errorf("Project(%s, ...) = %s, want %s", obj, x, want)
+	}
+}
+
+func TestUnproject(t *testing.T) {
+	var ortho, frustum, persp Mat4
+	ortho.Ortho(-10, 10, -5, 5, 1, 11)
+	frustum.Frustum(-1, 1.5, -0.5, 1, 1, 50)
+	persp.Perspective(Rad(60), 1.5, 0.5, 20)
+	var model, view Mat4
+	model.ID().Translate(&model, V3(1, -2, 0.5)).Rot(&model, 0.3, V3(1, 1, 0))
+	view.LookAt(V3(2, 3, 6), V3(0, 0, 0), V3UnitY)
+	viewport := Rect(20, 10, 820, 610)
+
+	for _, proj := range []*Mat4{&ortho, &frustum, &persp} {
+		for _, obj := range []Vec3{
+			V3(0, 0, 0),
+			V3(1, 0.5, -1),
+			V3(-0.5, 0.2, 0.8),
+		} {
+			win := Project(obj, &model, &view, proj, viewport)
+			x, ok := Unproject(win, &model, &view, proj, viewport)
+			if !ok {
+				t.Errorf("Unproject(%s, ...) reported singular matrix", win)
+				continue
+			}
+			if !x.NearEqAbs(obj, 1e-3) {
+				t.Errorf("Unproject(Project(%s, ...) = %s, ...) = %s, want %s",
+					obj, win, x, obj)
+			}
+		}
+	}
+
+	if _, ok := Unproject(V3(1, 1, 0), &zero, &view, &persp, viewport); ok {
+		t.Errorf("Unproject with zero model matrix reported ok")
+	}
+}
+
+func TestPickRay(t *testing.T) {
+	var view, persp Mat4
+	eye := V3(0, 0, 5)
+	view.LookAt(eye, V3(0, 0, 0), V3UnitY)
+	persp.Perspective(math.Pi/2, 1, 1, 100)
+	viewport := Rect(0, 0, 400, 400)
+
+	tests := []struct {
+		x, y   float32
+		origin Vec3
+		dir    Vec3
+	}{
+		{200, 200, V3(0, 0, 4), V3(0, 0, -1)},
+		{400, 200, V3(1, 0, 4), V3(1, 0, -1).Norm()},
+		{0, 400, V3(-1, 1, 4), V3(-1, 1, -1).Norm()},
+	}
+	for _, tt := range tests {
+		origin, dir, ok := PickRay(tt.x, tt.y, &view, &persp, viewport)
+		if !ok {
+			t.Errorf("PickRay(%g, %g, ...) reported singular matrix", tt.x, tt.y)
+			continue
+		}
+		if !origin.NearEqAbs(tt.origin, 1e-4) || !dir.NearEqAbs(tt.dir, 1e-4) {
+			t.Errorf("PickRay(%g, %g, ...) = %s, %s, want %s, %s",
+				tt.x, tt.y, origin, dir, tt.origin, tt.dir)
+		}
+	}
+
+	// The ray through the projected position of a point hits the point.
+	p := V3(1.5, -0.7, -3)
+	win := Project(p, &id, &view, &persp, viewport)
+	origin, dir, _ := PickRay(win.X, win.Y, &view, &persp, viewport)
+	hit := origin.Add(dir.Mul(p.Sub(origin).Dot(dir)))
+	if !hit.NearEqAbs(p, 1e-3) {
+		t.Errorf("pick ray %s + t*%s misses point %s, closest point %s", origin, dir, p, hit)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import "math"
+
+// A Quaternion represents a quaternion W + X*i + Y*j + Z*k. Unit
+// quaternions represent rotations in 3-dimensional euclidean space.
+type Quaternion[T Float] struct {
+	X, Y, Z, W T
+}
+
+// A Quat is a quaternion with float32 components.
+type Quat = Quaternion[float32]
+
+// A Quatd is a quaternion with float64 components.
+type Quatd = Quaternion[float64]
+
+// QuatID is the identity quaternion (0,0,0,1), representing no rotation.
+var QuatID = Quat{0, 0, 0, 1}
+
+// QuatRot returns the unit quaternion representing a rotation by the given
+// angle in radians around the given axis.
+func QuatRot[T Float](angle T, axis Vector3[T]) Quaternion[T] {
+	n := axis.Norm()
+	s := T(math.Sin(float64(angle / 2)))
+	c := T(math.Cos(float64(angle / 2)))
+	return Quaternion[T]{n.X * s, n.Y * s, n.Z * s, c}
+}
+
+// QuatEuler returns the unit quaternion representing a rotation by the
+// Euler angles x, y and z in radians. The rotations are applied around the
+// fixed x, y and z axes in this order.
+func QuatEuler(x, y, z float32) Quat {
+	sx, cx := math.Sincos(float64(x / 2))
+	sy, cy := math.Sincos(float64(y / 2))
+	sz, cz := math.Sincos(float64(z / 2))
+	return Quat{
+		float32(sx*cy*cz - cx*sy*sz),
+		float32(cx*sy*cz + sx*cy*sz),
+		float32(cx*cy*sz - sx*sy*cz),
+		float32(cx*cy*cz + sx*sy*sz),
+	}
+}
+
+// QuatFromMat4 returns the unit quaternion representing the rotation part
+// of matrix m. The upper-left 3x3 part of m must be a pure rotation matrix,
+// i.e. without scaling or shearing.
+func QuatFromMat4[T Float](m *Matrix4[T]) Quaternion[T] {
+	m00, m11, m22 := m[0][0], m[1][1], m[2][2]
+	var q Quaternion[T]
+	switch tr := m00 + m11 + m22; {
+	case tr > 0:
+		s := T(math.Sqrt(float64(tr+1))) * 2
+		q = Quaternion[T]{
+			(m[1][2] - m[2][1]) / s,
+			(m[2][0] - m[0][2]) / s,
+			(m[0][1] - m[1][0]) / s,
+			s / 4,
+		}
+	case m00 > m11 && m00 > m22:
+		s := T(math.Sqrt(float64(1+m00-m11-m22))) * 2
+		q = Quaternion[T]{
+			s / 4,
+			(m[1][0] + m[0][1]) / s,
+			(m[2][0] + m[0][2]) / s,
+			(m[1][2] - m[2][1]) / s,
+		}
+	case m11 > m22:
+		s := T(math.Sqrt(float64(1+m11-m00-m22))) * 2
+		q = Quaternion[T]{
+			(m[1][0] + m[0][1]) / s,
+			s / 4,
+			(m[2][1] + m[1][2]) / s,
+			(m[2][0] - m[0][2]) / s,
+		}
+	default:
+		s := T(math.Sqrt(float64(1+m22-m00-m11))) * 2
+		q = Quaternion[T]{
+			(m[2][0] + m[0][2]) / s,
+			(m[2][1] + m[1][2]) / s,
+			s / 4,
+			(m[0][1] - m[1][0]) / s,
+		}
+	}
+	return q
+}
+
+// Mul returns the Hamilton product q*r. As a rotation, the result
+// represents the rotation r followed by the rotation q.
+func (q Quaternion[T]) Mul(r Quaternion[T]) Quaternion[T] {
+	return Quaternion[T]{
+		q.W*r.X + q.X*r.W + q.Y*r.Z - q.Z*r.Y,
+		q.W*r.Y - q.X*r.Z + q.Y*r.W + q.Z*r.X,
+		q.W*r.Z + q.X*r.Y - q.Y*r.X + q.Z*r.W,
+		q.W*r.W - q.X*r.X - q.Y*r.Y - q.Z*r.Z,
+	}
+}
+
+// Conj returns the conjugate of q.
+func (q Quaternion[T]) Conj() Quaternion[T] {
+	return Quaternion[T]{-q.X, -q.Y, -q.Z, q.W}
+}
+
+// Inv returns the multiplicative inverse of q. For unit quaternions
+// this is the same as the conjugate.
+func (q Quaternion[T]) Inv() Quaternion[T] {
+	s := q.SqLen()
+	return Quaternion[T]{-q.X / s, -q.Y / s, -q.Z / s, q.W / s}
+}
+
+// Dot returns the dot product of q and r.
+func (q Quaternion[T]) Dot(r Quaternion[T]) T {
+	return q.X*r.X + q.Y*r.Y + q.Z*r.Z + q.W*r.W
+}
+
+// SqLen returns the square of the length (norm) of q.
+func (q Quaternion[T]) SqLen() T {
+	return q.Dot(q)
+}
+
+// Len returns the length (norm) of q.
+func (q Quaternion[T]) Len() T {
+	return T(math.Sqrt(float64(q.SqLen())))
+}
+
+// Norm returns the normalized (unit) quaternion of q.
+func (q Quaternion[T]) Norm() Quaternion[T] {
+	l := q.Len()
+	return Quaternion[T]{q.X / l, q.Y / l, q.Z / l, q.W / l}
+}
+
+// Rotate returns vector v rotated by the unit quaternion q.
+func (q Quaternion[T]) Rotate(v Vector3[T]) Vector3[T] {
+	// v' = v + 2w(u×v) + 2u×(u×v) with u = (q.X, q.Y, q.Z)
+	u := Vector3[T]{q.X, q.Y, q.Z}
+	t := u.Cross(v).Mul(2)
+	return v.Add(t.Mul(q.W)).Add(u.Cross(t))
+}
+
+// Nlerp returns the normalized linear interpolation between the unit
+// quaternions q and r by amount t, taking the shortest path.
+// It is faster than Slerp, but does not interpolate with constant
+// angular velocity.
+func (q Quaternion[T]) Nlerp(r Quaternion[T], t T) Quaternion[T] {
+	if q.Dot(r) < 0 {
+		r = Quaternion[T]{-r.X, -r.Y, -r.Z, -r.W}
+	}
+	return Quaternion[T]{
+		lerp(q.X, r.X, t),
+		lerp(q.Y, r.Y, t),
+		lerp(q.Z, r.Z, t),
+		lerp(q.W, r.W, t),
+	}.Norm()
+}
+
+// Slerp returns the spherical linear interpolation between the unit
+// quaternions q and r by amount t, taking the shortest path.
+// The amount t is usually a value between 0 and 1. If t=0 q will be
+// returned; if t=1 r will be returned.
+func (q Quaternion[T]) Slerp(r Quaternion[T], t T) Quaternion[T] {
+	cos := q.Dot(r)
+	if cos < 0 {
+		r = Quaternion[T]{-r.X, -r.Y, -r.Z, -r.W}
+		cos = -cos
+	}
+	if cos > 1-epsilon {
+		// The quaternions are almost parallel, fall back to Nlerp
+		// to avoid a division by zero.
+		return q.Nlerp(r, t)
+	}
+	θ := math.Acos(float64(cos))
+	sin := math.Sin(θ)
+	a := T(math.Sin((1-float64(t))*θ) / sin)
+	b := T(math.Sin(float64(t)*θ) / sin)
+	return Quaternion[T]{
+		a*q.X + b*r.X,
+		a*q.Y + b*r.Y,
+		a*q.Z + b*r.Z,
+		a*q.W + b*r.W,
+	}
+}
+
+// NearEq returns whether q and r are approximately equal. This relation is
+// not transitive in general. The tolerance for the floating-point components
+// is ±1e-5. Note that q and -q represent the same rotation, but are not
+// considered equal by NearEq.
+func (q Quaternion[T]) NearEq(r Quaternion[T]) bool {
+	return nearEq(q.X, r.X, epsilon) &&
+		nearEq(q.Y, r.Y, epsilon) &&
+		nearEq(q.Z, r.Z, epsilon) &&
+		nearEq(q.W, r.W, epsilon)
+}
+
+// String returns a string representation of q like "(0, 0, 0.7071, 0.7071)"
+// in the component order X, Y, Z, W.
+func (q Quaternion[T]) String() string {
+	return "(" + str(q.X) + ", " + str(q.Y) + ", " + str(q.Z) + ", " + str(q.W) + ")"
+}
+
+// Float32 returns q with its components converted to float32.
+func (q Quaternion[T]) Float32() Quat {
+	return Quat{float32(q.X), float32(q.Y), float32(q.Z), float32(q.W)}
+}
+
+// Float64 returns q with its components converted to float64.
+func (q Quaternion[T]) Float64() Quatd {
+	return Quatd{float64(q.X), float64(q.Y), float64(q.Z), float64(q.W)}
+}
@@ -0,0 +1,230 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuatRot(t *testing.T) {
+	tests := []struct {
+		rad  float32
+		axis Vec3
+		want Quat
+	}{
+		{0, V3UnitX, QuatID},
+		{math.Pi / 2, V3UnitZ, Quat{0, 0, 0.70710677, 0.70710677}},
+		{math.Pi, V3(0, 2, 0), Quat{0, 1, 0, 0}},
+		{math.Pi / 3, V3(1, 1, 1), Quat{0.28867513, 0.28867513, 0.28867513, 0.8660254}},
+	}
+	for _, tt := range tests {
+		if q := QuatRot(tt.rad, tt.axis); !q.NearEq(tt.want) {
+			t.Errorf("QuatRot(%g, %s) = %s, want %s", tt.rad, tt.axis, q, tt.want)
+		}
+	}
+}
+
+func TestQuatEuler(t *testing.T) {
+	tests := []struct {
+		x, y, z float32
+		want    Quat
+	}{
+		{0, 0, 0, QuatID},
+		{math.Pi / 2, 0, 0, QuatRot(math.Pi/2, V3UnitX)},
+		{0, math.Pi / 2, 0, QuatRot(math.Pi/2, V3UnitY)},
+		{0, 0, math.Pi / 2, QuatRot(math.Pi/2, V3UnitZ)},
+		{0.3, -1.2, 2.1, QuatRot(2.1, V3UnitZ).
+			Mul(QuatRot(-1.2, V3UnitY)).
+			Mul(QuatRot(0.3, V3UnitX))},
+	}
+	for _, tt := range tests {
+		if q := QuatEuler(tt.x, tt.y, tt.z); !q.NearEq(tt.want) {
+			t.Errorf("QuatEuler(%g, %g, %g) = %s, want %s", tt.x, tt.y, tt.z, q, tt.want)
+		}
+	}
+}
+
+func TestQuatFromMat4(t *testing.T) {
+	tests := []struct {
+		rad  float32
+		axis Vec3
+	}{
+		{0, V3UnitX},
+		{0.5, V3(1, 2, 3)},
+		{math.Pi * 0.9, V3UnitX},
+		{math.Pi * 0.9, V3UnitY},
+		{math.Pi * 0.9, V3UnitZ},
+		{math.Pi, V3(1, -1, 0.5)},
+		{-2.5, V3(-3, 1, 2)},
+	}
+	for _, tt := range tests {
+		var m Mat4
+		m.ID().Rot(&m, tt.rad, tt.axis)
+		want := QuatRot(tt.rad, tt.axis)
+		q := QuatFromMat4(&m)
+		if q.Dot(want) < 0 {
+			q = Quat{-q.X, -q.Y, -q.Z, -q.W}
+		}
+		if !q.NearEq(want) {
+			t.Errorf("QuatFromMat4(%v) = %s, want %s", m, q, want)
+		}
+	}
+}
+
+func TestQuatMul(t *testing.T) {
+	tests := []struct {
+		q, r, want Quat
+	}{
+		{QuatID, QuatID, QuatID},
+		{Quat{1, 2, 3, 4}, QuatID, Quat{1, 2, 3, 4}},
+		{Quat{1, 0, 0, 0}, Quat{0, 1, 0, 0}, Quat{0, 0, 1, 0}},
+		{Quat{0, 1, 0, 0}, Quat{1, 0, 0, 0}, Quat{0, 0, -1, 0}},
+		{Quat{1, 2, 3, 4}, Quat{5, 6, 7, 8}, Quat{24, 48, 48, -6}},
+		{QuatRot(0.5, V3UnitZ), QuatRot(0.25, V3UnitZ), QuatRot(0.75, V3UnitZ)},
+	}
+	for _, tt := range tests {
+		if x := tt.q.Mul(tt.r); !x.NearEq(tt.want) {
+			t.Errorf("%s * %s = %s, want %s", tt.q, tt.r, x, tt.want)
+		}
+	}
+}
+
+func TestQuatConjInv(t *testing.T) {
+	tests := []struct {
+		q, conj, inv Quat
+	}{
+		{QuatID, QuatID, QuatID},
+		{Quat{1, 2, 3, 4}, Quat{-1, -2, -3, 4}, Quat{-1.0 / 30, -2.0 / 30, -3.0 / 30, 4.0 / 30}},
+		{Quat{0, 0.6, 0, 0.8}, Quat{0, -0.6, 0, 0.8}, Quat{0, -0.6, 0, 0.8}},
+	}
+	for _, tt := range tests {
+		if x := tt.q.Conj(); !x.NearEq(tt.conj) {
+			t.Errorf("%s.Conj() = %s, want %s", tt.q, x, tt.conj)
+		}
+		if x := tt.q.Inv(); !x.NearEq(tt.inv) {
+			t.Errorf("%s.Inv() = %s, want %s", tt.q, x, tt.inv)
+		}
+		if x := tt.q.Mul(tt.q.Inv()); !x.NearEq(QuatID) {
+			t.Errorf("%s * %s = %s, want %s", tt.q, tt.q.Inv(), x, QuatID)
+		}
+	}
+}
+
+func TestQuatLenNorm(t *testing.T) {
+	tests := []struct {
+		q    Quat
+		len  float32
+		norm Quat
+	}{
+		{QuatID, 1, QuatID},
+		{Quat{0, 0, 0, 2}, 2, QuatID},
+		{Quat{1, 2, 3, 4}, 5.477226, Quat{0.18257418, 0.36514837, 0.5477226, 0.73029673}},
+	}
+	for _, tt := range tests {
+		if x := tt.q.Len(); !nearEq(x, tt.len, epsilon) {
+			t.Errorf("%s.Len() = %g, want %g", tt.q, x, tt.len)
+		}
+		if x := tt.q.Norm(); !x.NearEq(tt.norm) {
+			t.Errorf("%s.Norm() = %s, want %s", tt.q, x, tt.norm)
+		}
+	}
+}
+
+func TestQuatRotate(t *testing.T) {
+	tests := []struct {
+		q    Quat
+		v    Vec3
+		want Vec3
+	}{
+		{QuatID, V3(1, 2, 3), V3(1, 2, 3)},
+		{QuatRot(math.Pi/2, V3UnitZ), V3(1, 0, 2), V3(0, 1, 2)},
+		{QuatRot(math.Pi/2, V3UnitX), V3(0, 1, 0), V3(0, 0, 1)},
+		{QuatRot(math.Pi, V3UnitY), V3(1, 2, 3), V3(-1, 2, -3)},
+	}
+	for _, tt := range tests {
+		if x := tt.q.Rotate(tt.v); !x.NearEq(tt.want) {
+			t.Errorf("%s.Rotate(%s) = %s, want %s", tt.q, tt.v, x, tt.want)
+		}
+	}
+
+	// Rotating a vector must agree with the corresponding rotation matrix.
+	var m Mat4
+	m.ID().Rot(&m, 1.3, V3(2, -1, 0.5))
+	q := QuatRot(1.3, V3(2, -1, 0.5))
+	v := V3(0.5, 4, -2)
+	if x, want := q.Rotate(v), v.Transform(&m); !x.NearEq(want) {
+		t.Errorf("%s.Rotate(%s) = %s, want %s", q, v, x, want)
+	}
+}
+
+func TestQuatSlerp(t *testing.T) {
+	a := QuatRot(0, V3UnitY)
+	b := QuatRot(math.Pi/2, V3UnitY)
+	tests := []struct {
+		q, r Quat
+		t    float32
+		want Quat
+	}{
+		{a, b, 0, a},
+		{a, b, 1, b},
+		{a, b, 0.5, QuatRot(math.Pi/4, V3UnitY)},
+		{a, b, 0.25, QuatRot(math.Pi/8, V3UnitY)},
+		// Shortest path: -b represents the same rotation as b.
+		{a, Quat{-b.X, -b.Y, -b.Z, -b.W}, 0.5, QuatRot(math.Pi/4, V3UnitY)},
+		// Nearly parallel quaternions
+		{a, a, 0.5, a},
+	}
+	for _, tt := range tests {
+		if x := tt.q.Slerp(tt.r, tt.t); !x.NearEq(tt.want) {
+			t.Errorf("%s.Slerp(%s, %g) = %s, want %s", tt.q, tt.r, tt.t, x, tt.want)
+		}
+	}
+}
+
+func TestQuatNlerp(t *testing.T) {
+	a := QuatRot(0, V3UnitY)
+	b := QuatRot(math.Pi/2, V3UnitY)
+	tests := []struct {
+		q, r Quat
+		t    float32
+		want Quat
+	}{
+		{a, b, 0, a},
+		{a, b, 1, b},
+		{a, b, 0.5, QuatRot(math.Pi/4, V3UnitY)},
+		{a, Quat{-b.X, -b.Y, -b.Z, -b.W}, 0.5, QuatRot(math.Pi/4, V3UnitY)},
+	}
+	for _, tt := range tests {
+		if x := tt.q.Nlerp(tt.r, tt.t); !x.NearEq(tt.want) {
+			t.Errorf("%s.Nlerp(%s, %g) = %s, want %s", tt.q, tt.r, tt.t, x, tt.want)
+		}
+	}
+}
+
+func TestQuatString(t *testing.T) {
+	tests := []struct {
+		q    Quat
+		want string
+	}{
+		{QuatID, "(0, 0, 0, 1)"},
+		{Quat{1.5, -2, 0.25, 3}, "(1.5, -2, 0.25, 3)"},
+	}
+	for _, tt := range tests {
+		if s := tt.q.String(); s != tt.want {
+			t.Errorf("%#v.String() = %q, want %q", tt.q, s, tt.want)
+		}
+	}
+}
+
+func TestQuatdRot(t *testing.T) {
+	q := QuatRot(math.Pi/2, V3d(0, 0, 1))
+	if v := q.Rotate(V3d(1, 0, 0)); math.Abs(v.X) > 1e-12 || math.Abs(v.Y-1) > 1e-12 {
+		t.Errorf("%s.Rotate((1, 0, 0)) = %s, want (0, 1, 0)", q, v)
+	}
+	if x := q.Float32(); !x.NearEq(QuatRot(math.Pi/2, V3(0, 0, 1))) {
+		t.Errorf("%s.Float32() = %s", q, x)
+	}
+}
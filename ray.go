@@ -0,0 +1,200 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import "math"
+
+// A Ray represents a half-line in 3-dimensional euclidean space, starting
+// at point Origin and extending in direction Dir. The points of the ray are
+// Origin + t*Dir for t >= 0.
+type Ray struct {
+	Origin Vec3
+	Dir    Vec3
+}
+
+// A RayHit describes the intersection of a ray with a surface.
+type RayHit struct {
+	// T is the ray parameter of the intersection point Origin + T*Dir.
+	// If Dir is normalized, T is the distance from the ray origin.
+	T float32
+	// Normal is the unit surface normal at the intersection point.
+	Normal Vec3
+	// U and V are the barycentric coordinates of the intersection point
+	// for triangles, such that the point is (1-U-V)*a + U*b + V*c.
+	// They are zero for other surfaces.
+	U, V float32
+}
+
+// At returns the point Origin + t*Dir of the ray.
+func (r Ray) At(t float32) Vec3 {
+	return r.Origin.Add(r.Dir.Mul(t))
+}
+
+// IntersectPlane returns the intersection of the ray with the plane p. The
+// hit normal is the normal of p normalized. It reports false if the ray is
+// parallel to the plane or points away from it.
+func (r Ray) IntersectPlane(p Plane) (RayHit, bool) {
+	denom := p.Normal.Dot(r.Dir)
+	if denom == 0 {
+		return RayHit{}, false
+	}
+	t := -p.Dist(r.Origin) / denom
+	if t < 0 {
+		return RayHit{}, false
+	}
+	return RayHit{T: t, Normal: p.Normal.Norm()}, true
+}
+
+// IntersectSphere returns the nearest intersection of the ray with the
+// surface of the sphere with the given center and radius. The hit normal
+// points outwards. If the ray origin is inside the sphere, the intersection
+// where the ray leaves the sphere is returned. It reports false if the ray
+// misses the sphere.
+func (r Ray) IntersectSphere(center Vec3, radius float32) (RayHit, bool) {
+	oc := r.Origin.Sub(center)
+	a := r.Dir.SqLen()
+	b := oc.Dot(r.Dir)
+	c := oc.SqLen() - radius*radius
+	disc := b*b - a*c
+	if disc < 0 {
+		return RayHit{}, false
+	}
+	sq := float32(math.Sqrt(float64(disc)))
+	t := (-b - sq) / a
+	if t < 0 {
+		t = (-b + sq) / a
+		if t < 0 {
+			return RayHit{}, false
+		}
+	}
+	return RayHit{T: t, Normal: r.At(t).Sub(center).Div(radius)}, true
+}
+
+// IntersectAABB returns the nearest intersection of the ray with the surface
+// of the axis-aligned box spanned by the corners lo and hi, using the slab
+// method. The hit normal points outwards. If the ray origin is inside the
+// box, the intersection where the ray leaves the box is returned. It reports
+// false if the ray misses the box.
+func (r Ray) IntersectAABB(lo, hi Vec3) (RayHit, bool) {
+	o := [3]float32{r.Origin.X, r.Origin.Y, r.Origin.Z}
+	d := [3]float32{r.Dir.X, r.Dir.Y, r.Dir.Z}
+	l := [3]float32{lo.X, lo.Y, lo.Z}
+	h := [3]float32{hi.X, hi.Y, hi.Z}
+
+	tNear := float32(math.Inf(-1))
+	tFar := float32(math.Inf(1))
+	nearAxis, farAxis := -1, -1
+	for i := range 3 {
+		if d[i] == 0 {
+			if o[i] < l[i] || o[i] > h[i] {
+				return RayHit{}, false
+			}
+			continue
+		}
+		inv := 1 / d[i]
+		t0 := (l[i] - o[i]) * inv
+		t1 := (h[i] - o[i]) * inv
+		if t0 > t1 {
+			t0, t1 = t1, t0
+		}
+		if t0 > tNear {
+			tNear, nearAxis = t0, i
+		}
+		if t1 < tFar {
+			tFar, farAxis = t1, i
+		}
+		if tNear > tFar || tFar < 0 {
+			return RayHit{}, false
+		}
+	}
+
+	t, axis, sign := tNear, nearAxis, float32(-1)
+	if t < 0 {
+		// The ray starts inside the box.
+		t, axis, sign = tFar, farAxis, 1
+	}
+	if axis < 0 {
+		// Degenerate direction (zero vector)
+		return RayHit{}, false
+	}
+	var n [3]float32
+	if d[axis] < 0 {
+		n[axis] = -sign
+	} else {
+		n[axis] = sign
+	}
+	return RayHit{T: t, Normal: Vec3{n[0], n[1], n[2]}}, true
+}
+
+// IntersectBox returns the nearest intersection of the ray with the surface
+// of box b. See IntersectAABB for details.
+func (r Ray) IntersectBox(b Box) (RayHit, bool) {
+	return r.IntersectAABB(b.Min, b.Max)
+}
+
+// IntersectTriangle returns the intersection of the ray with the triangle
+// with the vertices a, b and c, using the Möller–Trumbore algorithm.
+// The hit normal is the normalized (b-a)×(c-a), i.e. it faces the side
+// from which the vertices appear in counterclockwise order. Both sides of
+// the triangle are hit. It reports false if the ray misses the triangle.
+func (r Ray) IntersectTriangle(a, b, c Vec3) (RayHit, bool) {
+	e1 := b.Sub(a)
+	e2 := c.Sub(a)
+	p := r.Dir.Cross(e2)
+	det := e1.Dot(p)
+	if det == 0 {
+		// The ray is parallel to the triangle.
+		return RayHit{}, false
+	}
+	inv := 1 / det
+	s := r.Origin.Sub(a)
+	u := s.Dot(p) * inv
+	if u < 0 || u > 1 {
+		return RayHit{}, false
+	}
+	q := s.Cross(e1)
+	v := r.Dir.Dot(q) * inv
+	if v < 0 || u+v > 1 {
+		return RayHit{}, false
+	}
+	t := e2.Dot(q) * inv
+	if t < 0 {
+		return RayHit{}, false
+	}
+	return RayHit{T: t, Normal: e1.Cross(e2).Norm(), U: u, V: v}, true
+}
+
+// A Ray2 represents a half-line in 2-dimensional euclidean space, starting
+// at point Origin and extending in direction Dir. The points of the ray are
+// Origin + t*Dir for t >= 0.
+type Ray2 struct {
+	Origin Vec2
+	Dir    Vec2
+}
+
+// A RayHit2 describes the intersection of a 2-dimensional ray with a
+// curve, like the edge of a rectangle.
+type RayHit2 struct {
+	// T is the ray parameter of the intersection point Origin + T*Dir.
+	// If Dir is normalized, T is the distance from the ray origin.
+	T float32
+	// Normal is the unit normal of the curve at the intersection point.
+	Normal Vec2
+}
+
+// At returns the point Origin + t*Dir of the ray.
+func (r Ray2) At(t float32) Vec2 {
+	return r.Origin.Add(r.Dir.Mul(t))
+}
+
+// IntersectRect returns the nearest intersection of the ray with the
+// boundary of rectangle rect. The hit normal is the outward pointing unit
+// normal of the hit edge. If the ray origin is inside the rectangle, the
+// intersection where the ray leaves the rectangle is returned. It reports
+// false if the ray misses the rectangle.
+func (r Ray2) IntersectRect(rect Rectangle) (RayHit2, bool) {
+	hit, ok := Ray{r.Origin.Z(0), r.Dir.Z(0)}.IntersectAABB(rect.Min.Z(0), rect.Max.Z(0))
+	return RayHit2{T: hit.T, Normal: Vec2{hit.Normal.X, hit.Normal.Y}}, ok
+}
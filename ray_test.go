@@ -0,0 +1,171 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import "testing"
+
+func TestRayAt(t *testing.T) {
+	r := Ray{V3(1, 2, 3), V3(0, -1, 2)}
+	tests := []struct {
+		t    float32
+		want Vec3
+	}{
+		{0, V3(1, 2, 3)},
+		{1, V3(1, 1, 5)},
+		{2.5, V3(1, -0.5, 8)},
+	}
+	for _, tt := range tests {
+		if x := r.At(tt.t); !x.NearEq(tt.want) {
+			t.Errorf("%v.At(%g) = %s, want %s", r, tt.t, x, tt.want)
+		}
+	}
+}
+
+func TestRayIntersectPlane(t *testing.T) {
+	tests := []struct {
+		r    Ray
+		p    Plane
+		want RayHit
+		ok   bool
+	}{
+		{Ray{V3(0, 5, 0), V3(0, -1, 0)}, Plane{V3UnitY, 0}, RayHit{T: 5, Normal: V3UnitY}, true},
+		{Ray{V3(1, 5, 2), V3(0, -2, 0)}, Plane{V3UnitY, -1}, RayHit{T: 2, Normal: V3UnitY}, true},
+		{Ray{V3(0, 0, 0), V3(1, 1, 0)}, Plane{V3(2, 0, 0), -6}, RayHit{T: 3, Normal: V3UnitX}, true},
+		// Pointing away
+		{Ray{V3(0, 5, 0), V3(0, 1, 0)}, Plane{V3UnitY, 0}, RayHit{}, false},
+		// Parallel
+		{Ray{V3(0, 5, 0), V3(1, 0, 0)}, Plane{V3UnitY, 0}, RayHit{}, false},
+	}
+	for _, tt := range tests {
+		hit, ok := tt.r.IntersectPlane(tt.p)
+		if ok != tt.ok || !rayHitNearEq(hit, tt.want) {
+			t.Errorf("%v.IntersectPlane(%v) = %v, %v, want %v, %v",
+				tt.r, tt.p, hit, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRayIntersectSphere(t *testing.T) {
+	tests := []struct {
+		r      Ray
+		center Vec3
+		radius float32
+		want   RayHit
+		ok     bool
+	}{
+		{Ray{V3(0, 0, 10), V3(0, 0, -1)}, V3Zero, 2, RayHit{T: 8, Normal: V3UnitZ}, true},
+		{Ray{V3(-5, 1, 0), V3(2, 0, 0)}, V3(0, 1, 0), 1, RayHit{T: 2, Normal: V3(-1, 0, 0)}, true},
+		// Origin inside the sphere
+		{Ray{V3(0, 0, 0), V3(0, 1, 0)}, V3Zero, 3, RayHit{T: 3, Normal: V3UnitY}, true},
+		// Sphere behind the ray
+		{Ray{V3(0, 0, 10), V3(0, 0, 1)}, V3Zero, 2, RayHit{}, false},
+		// Miss
+		{Ray{V3(0, 3, 10), V3(0, 0, -1)}, V3Zero, 2, RayHit{}, false},
+	}
+	for _, tt := range tests {
+		hit, ok := tt.r.IntersectSphere(tt.center, tt.radius)
+		if ok != tt.ok || !rayHitNearEq(hit, tt.want) {
+			t.Errorf("%v.IntersectSphere(%s, %g) = %v, %v, want %v, %v",
+				tt.r, tt.center, tt.radius, hit, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRayIntersectAABB(t *testing.T) {
+	lo, hi := V3(-1, -1, -1), V3(1, 2, 3)
+	tests := []struct {
+		r    Ray
+		want RayHit
+		ok   bool
+	}{
+		{Ray{V3(-5, 0, 0), V3(1, 0, 0)}, RayHit{T: 4, Normal: V3(-1, 0, 0)}, true},
+		{Ray{V3(0, 5, 0), V3(0, -1, 0)}, RayHit{T: 3, Normal: V3(0, 1, 0)}, true},
+		{Ray{V3(0, 0, 10), V3(0, 0, -2)}, RayHit{T: 3.5, Normal: V3(0, 0, 1)}, true},
+		{Ray{V3(-3, -3, 0), V3(1, 1, 0)}, RayHit{T: 2, Normal: V3(-1, 0, 0)}, true},
+		// Origin inside the box
+		{Ray{V3(0, 0, 0), V3(0, 0, 1)}, RayHit{T: 3, Normal: V3(0, 0, 1)}, true},
+		// Box behind the ray
+		{Ray{V3(-5, 0, 0), V3(-1, 0, 0)}, RayHit{}, false},
+		// Miss
+		{Ray{V3(-5, 3, 0), V3(1, 0, 0)}, RayHit{}, false},
+		{Ray{V3(-5, 0, 0), V3(1, 1, 0)}, RayHit{}, false},
+	}
+	for _, tt := range tests {
+		hit, ok := tt.r.IntersectAABB(lo, hi)
+		if ok != tt.ok || !rayHitNearEq(hit, tt.want) {
+			t.Errorf("%v.IntersectAABB(%s, %s) = %v, %v, want %v, %v",
+				tt.r, lo, hi, hit, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRayIntersectTriangle(t *testing.T) {
+	a, b, c := V3(0, 0, 0), V3(2, 0, 0), V3(0, 2, 0)
+	tests := []struct {
+		r    Ray
+		want RayHit
+		ok   bool
+	}{
+		{Ray{V3(0.5, 0.5, 5), V3(0, 0, -1)}, RayHit{T: 5, Normal: V3UnitZ, U: 0.25, V: 0.25}, true},
+		{Ray{V3(1, 0.5, -2), V3(0, 0, 1)}, RayHit{T: 2, Normal: V3UnitZ, U: 0.5, V: 0.25}, true},
+		{Ray{V3(0, 0, 1), V3(0, 0, -1)}, RayHit{T: 1, Normal: V3UnitZ}, true},
+		// Outside the triangle
+		{Ray{V3(1.5, 1.5, 5), V3(0, 0, -1)}, RayHit{}, false},
+		{Ray{V3(-0.1, 0.5, 5), V3(0, 0, -1)}, RayHit{}, false},
+		// Behind the ray
+		{Ray{V3(0.5, 0.5, 5), V3(0, 0, 1)}, RayHit{}, false},
+		// Parallel
+		{Ray{V3(0.5, 0.5, 5), V3(1, 0, 0)}, RayHit{}, false},
+	}
+	for _, tt := range tests {
+		hit, ok := tt.r.IntersectTriangle(a, b, c)
+		if ok != tt.ok || !rayHitNearEq(hit, tt.want) {
+			t.Errorf("%v.IntersectTriangle(%s, %s, %s) = %v, %v, want %v, %v",
+				tt.r, a, b, c, hit, ok, tt.want, tt.ok)
+		}
+		if ok {
+			p := a.Mul(1 - hit.U - hit.V).Add(b.Mul(hit.U)).Add(c.Mul(hit.V))
+			if !p.NearEq(tt.r.At(hit.T)) {
+				t.Errorf("barycentric point %s != hit point %s", p, tt.r.At(hit.T))
+			}
+		}
+	}
+}
+
+func TestRay2IntersectRect(t *testing.T) {
+	rect := Rect(0, 0, 4, 2)
+	tests := []struct {
+		r    Ray2
+		want RayHit2
+		ok   bool
+	}{
+		{Ray2{V2(-2, 1), V2(1, 0)}, RayHit2{T: 2, Normal: V2(-1, 0)}, true},
+		{Ray2{V2(1, 5), V2(0, -1)}, RayHit2{T: 3, Normal: V2(0, 1)}, true},
+		{Ray2{V2(1, 1), V2(-1, 0)}, RayHit2{T: 1, Normal: V2(-1, 0)}, true},
+		{Ray2{V2(-2, 3), V2(1, 0)}, RayHit2{}, false},
+		{Ray2{V2(-2, 1), V2(-1, 0)}, RayHit2{}, false},
+	}
+	for _, tt := range tests {
+		hit, ok := tt.r.IntersectRect(rect)
+		if ok != tt.ok || !nearEq(hit.T, tt.want.T, epsilon) || !hit.Normal.NearEq(tt.want.Normal) {
+			t.Errorf("%v.IntersectRect(%v) = %v, %v, want %v, %v",
+				tt.r, rect, hit, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func rayHitNearEq(a, b RayHit) bool {
+	return nearEq(a.T, b.T, epsilon) && a.Normal.NearEq(b.Normal) &&
+		nearEq(a.U, b.U, epsilon) && nearEq(a.V, b.V, epsilon)
+}
+
+func TestRayIntersectBox(t *testing.T) {
+	b := Box{V3(-1, -1, -1), V3(1, 2, 3)}
+	r := Ray{V3(-5, 0, 0), V3(1, 0, 0)}
+	want := RayHit{T: 4, Normal: V3(-1, 0, 0)}
+	if hit, ok := r.IntersectBox(b); !ok || !rayHitNearEq(hit, want) {
+		t.Errorf("%v.IntersectBox(%v) = %v, %v, want %v, true", r, b, hit, ok, want)
+	}
+}
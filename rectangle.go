@@ -4,8 +4,14 @@
 
 package geom
 
+import (
+	"image"
+	"math"
+)
+
 // A Rectangle contains the points with Min.X <= X <= Max.X, Min.Y <= Y <= Max.Y.
-// It is well-formed if Min.X <= Max.X and likewise for Y.
+// It is well-formed if Min.X <= Max.X and likewise for Y. A Rectangle that is
+// not well-formed is empty.
 type Rectangle struct {
 	Min Vec2
 	Max Vec2
@@ -24,13 +30,190 @@ func RectSized(pos Vec2, size Size) Rectangle {
 	return Rectangle{Min: pos, Max: Vec2{X: pos.X + size.W, Y: pos.Y + size.H}}
 }
 
+// RectFromImage converts the integer rectangle r of the image package to a
+// Rectangle.
+func RectFromImage(r image.Rectangle) Rectangle {
+	return Rect(float32(r.Min.X), float32(r.Min.Y), float32(r.Max.X), float32(r.Max.Y))
+}
+
 // Contains reports whether the rectangle contains point pt.
 func (r Rectangle) Contains(pt Vec2) bool {
 	return (r.Min.X <= pt.X && pt.X <= r.Max.X) &&
 		(r.Min.Y <= pt.Y && pt.Y <= r.Max.Y)
 }
 
+// ContainsRect reports whether rectangle s is entirely contained in r.
+// An empty rectangle is contained in any rectangle.
+func (r Rectangle) ContainsRect(s Rectangle) bool {
+	if s.Empty() {
+		return true
+	}
+	return r.Contains(s.Min) && r.Contains(s.Max)
+}
+
 // Size returns the dimensions (width and height) of the rectangle.
 func (r *Rectangle) Size() Size {
 	return Size{W: r.Max.X - r.Min.X, H: r.Max.Y - r.Min.Y}
 }
+
+// Dx returns r's width.
+func (r Rectangle) Dx() float32 {
+	return r.Max.X - r.Min.X
+}
+
+// Dy returns r's height.
+func (r Rectangle) Dy() float32 {
+	return r.Max.Y - r.Min.Y
+}
+
+// Center returns the center point of the rectangle.
+func (r Rectangle) Center() Vec2 {
+	return r.Min.Add(r.Max).Mul(0.5)
+}
+
+// Add returns the rectangle r translated by v.
+func (r Rectangle) Add(v Vec2) Rectangle {
+	return Rectangle{Min: r.Min.Add(v), Max: r.Max.Add(v)}
+}
+
+// Sub returns the rectangle r translated by -v.
+func (r Rectangle) Sub(v Vec2) Rectangle {
+	return Rectangle{Min: r.Min.Sub(v), Max: r.Max.Sub(v)}
+}
+
+// Inset returns the rectangle r inset by n, which may be negative. If either
+// of r's dimensions is less than 2*n, a rectangle collapsed to r's center
+// along that axis is returned.
+func (r Rectangle) Inset(n float32) Rectangle {
+	if r.Dx() < 2*n {
+		r.Min.X = (r.Min.X + r.Max.X) / 2
+		r.Max.X = r.Min.X
+	} else {
+		r.Min.X += n
+		r.Max.X -= n
+	}
+	if r.Dy() < 2*n {
+		r.Min.Y = (r.Min.Y + r.Max.Y) / 2
+		r.Max.Y = r.Min.Y
+	} else {
+		r.Min.Y += n
+		r.Max.Y -= n
+	}
+	return r
+}
+
+// Intersect returns the largest rectangle contained by both r and s. If the
+// two rectangles do not overlap, the result is empty.
+func (r Rectangle) Intersect(s Rectangle) Rectangle {
+	return Rectangle{Min: r.Min.Max(s.Min), Max: r.Max.Min(s.Max)}
+}
+
+// Union returns the smallest rectangle that contains both r and s.
+// An empty rectangle does not contribute to the union.
+func (r Rectangle) Union(s Rectangle) Rectangle {
+	if r.Empty() {
+		return s
+	}
+	if s.Empty() {
+		return r
+	}
+	return Rectangle{Min: r.Min.Min(s.Min), Max: r.Max.Max(s.Max)}
+}
+
+// Overlaps reports whether r and s have a non-empty intersection.
+func (r Rectangle) Overlaps(s Rectangle) bool {
+	return !r.Intersect(s).Empty()
+}
+
+// Empty reports whether the rectangle contains no points.
+func (r Rectangle) Empty() bool {
+	return r.Min.X > r.Max.X || r.Min.Y > r.Max.Y
+}
+
+// Canon returns the canonical version of r. The returned rectangle has
+// minimum and maximum coordinates swapped if necessary so that it is
+// well-formed.
+func (r Rectangle) Canon() Rectangle {
+	return Rectangle{Min: r.Min.Min(r.Max), Max: r.Min.Max(r.Max)}
+}
+
+// Eq reports whether r and s contain the same set of points. All empty
+// rectangles are considered equal.
+func (r Rectangle) Eq(s Rectangle) bool {
+	return r == s || r.Empty() && s.Empty()
+}
+
+// NearEq returns whether r and s are approximately equal. This relation is
+// not transitive in general. The tolerance for the floating-point components
+// is ±1e-5.
+func (r Rectangle) NearEq(s Rectangle) bool {
+	return r.Min.NearEq(s.Min) && r.Max.NearEq(s.Max)
+}
+
+// NearEqAbs returns whether r and s are approximately equal, with each pair
+// of corner coordinates differing by at most the absolute tolerance tol.
+// See the NearEqAbs function.
+func (r Rectangle) NearEqAbs(s Rectangle, tol float32) bool {
+	return r.Min.NearEqAbs(s.Min, tol) && r.Max.NearEqAbs(s.Max, tol)
+}
+
+// NearEqRel returns whether r and s are approximately equal, with each pair
+// of corner coordinates differing by at most the relative tolerance tol.
+// See the NearEqRel function.
+func (r Rectangle) NearEqRel(s Rectangle, tol float32) bool {
+	return r.Min.NearEqRel(s.Min, tol) && r.Max.NearEqRel(s.Max, tol)
+}
+
+// NearEqULP returns whether r and s are approximately equal, with each pair
+// of corner coordinates at most ulps units in the last place apart.
+// See the NearEqULP function.
+func (r Rectangle) NearEqULP(s Rectangle, ulps uint) bool {
+	return r.Min.NearEqULP(s.Min, ulps) && r.Max.NearEqULP(s.Max, ulps)
+}
+
+// A RoundingMode specifies how the floating-point coordinates of a
+// Rectangle are converted to integers.
+type RoundingMode int
+
+const (
+	// RoundNearest rounds all coordinates to the nearest integer,
+	// rounding half away from zero.
+	RoundNearest RoundingMode = iota
+	// RoundDown rounds all coordinates towards negative infinity.
+	RoundDown
+	// RoundUp rounds all coordinates towards positive infinity.
+	RoundUp
+	// RoundOut rounds Min down and Max up, resulting in the smallest
+	// integer rectangle that contains the rectangle.
+	RoundOut
+	// RoundIn rounds Min up and Max down, resulting in the largest
+	// integer rectangle that is contained in the rectangle.
+	RoundIn
+)
+
+// ImageRect converts r to an integer rectangle of the image package, rounding
+// the coordinates according to the given rounding mode.
+func (r Rectangle) ImageRect(mode RoundingMode) image.Rectangle {
+	var minFn, maxFn func(float64) float64
+	switch mode {
+	case RoundDown:
+		minFn, maxFn = math.Floor, math.Floor
+	case RoundUp:
+		minFn, maxFn = math.Ceil, math.Ceil
+	case RoundOut:
+		minFn, maxFn = math.Floor, math.Ceil
+	case RoundIn:
+		minFn, maxFn = math.Ceil, math.Floor
+	default:
+		minFn, maxFn = math.Round, math.Round
+	}
+	return image.Rectangle{
+		Min: image.Pt(int(minFn(float64(r.Min.X))), int(minFn(float64(r.Min.Y)))),
+		Max: image.Pt(int(maxFn(float64(r.Max.X))), int(maxFn(float64(r.Max.Y)))),
+	}
+}
+
+// String returns a string representation of r like "(3, 4)-(6, 5.5)".
+func (r Rectangle) String() string {
+	return r.Min.String() + "-" + r.Max.String()
+}
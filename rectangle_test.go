@@ -4,7 +4,11 @@
 
 package geom
 
-import "testing"
+import (
+	"image"
+	"math"
+	"testing"
+)
 
 func TestRect(t *testing.T) {
 	tests := []struct {
@@ -80,6 +84,246 @@ func TestRectangleSize(t *testing.T) {
 	}
 }
 
+func TestRectFromImage(t *testing.T) {
+	tests := []struct {
+		r    image.Rectangle
+		want Rectangle
+	}{
+		{image.Rect(0, 0, 0, 0), Rect(0, 0, 0, 0)},
+		{image.Rect(-10, 5, 320, 200), Rect(-10, 5, 320, 200)},
+	}
+	for _, tt := range tests {
+		if rect := RectFromImage(tt.r); rect != tt.want {
+			t.Errorf("RectFromImage(%v) was: %v, want: %v", tt.r, rect, tt.want)
+		}
+	}
+}
+
+func TestRectangleContains(t *testing.T) {
+	tests := []struct {
+		rect Rectangle
+		pt   Vec2
+		want bool
+	}{
+		{Rect(0, 0, 10, 5), V2(5, 2.5), true},
+		{Rect(0, 0, 10, 5), V2(0, 0), true},
+		{Rect(0, 0, 10, 5), V2(10, 5), true},
+		{Rect(0, 0, 10, 5), V2(10.1, 2), false},
+		{Rect(0, 0, 10, 5), V2(5, -0.1), false},
+	}
+	for _, tt := range tests {
+		if x := tt.rect.Contains(tt.pt); x != tt.want {
+			t.Errorf("%v.Contains(%v) was: %v, want: %v", tt.rect, tt.pt, x, tt.want)
+		}
+	}
+}
+
+func TestRectangleContainsRect(t *testing.T) {
+	tests := []struct {
+		r, s Rectangle
+		want bool
+	}{
+		{Rect(0, 0, 10, 5), Rect(0, 0, 10, 5), true},
+		{Rect(0, 0, 10, 5), Rect(2, 1, 8, 4), true},
+		{Rect(0, 0, 10, 5), Rect(2, 1, 11, 4), false},
+		{Rect(0, 0, 10, 5), Rect(-1, -1, 1, 1), false},
+		{Rect(0, 0, 10, 5), Rect(20, 20, 15, 15), true},
+	}
+	for _, tt := range tests {
+		if x := tt.r.ContainsRect(tt.s); x != tt.want {
+			t.Errorf("%v.ContainsRect(%v) was: %v, want: %v", tt.r, tt.s, x, tt.want)
+		}
+	}
+}
+
+func TestRectangleDxDyCenter(t *testing.T) {
+	tests := []struct {
+		rect   Rectangle
+		dx, dy float32
+		center Vec2
+	}{
+		{Rect(0, 0, 0, 0), 0, 0, V2(0, 0)},
+		{Rect(0, 0, 15, 28), 15, 28, V2(7.5, 14)},
+		{Rect(2.5, 4, 5.2, 6.1), 2.7, 2.1, V2(3.85, 5.05)},
+		{Rect(-250, -320, 110, 230), 360, 550, V2(-70, -45)},
+	}
+	for _, tt := range tests {
+		if x := tt.rect.Dx(); !nearEq(x, tt.dx, epsilon) {
+			t.Errorf("%v.Dx() was: %g, want: %g", tt.rect, x, tt.dx)
+		}
+		if x := tt.rect.Dy(); !nearEq(x, tt.dy, epsilon) {
+			t.Errorf("%v.Dy() was: %g, want: %g", tt.rect, x, tt.dy)
+		}
+		if x := tt.rect.Center(); !x.NearEq(tt.center) {
+			t.Errorf("%v.Center() was: %v, want: %v", tt.rect, x, tt.center)
+		}
+	}
+}
+
+func TestRectangleAddSub(t *testing.T) {
+	tests := []struct {
+		rect     Rectangle
+		v        Vec2
+		add, sub Rectangle
+	}{
+		{Rect(0, 0, 10, 5), V2(0, 0), Rect(0, 0, 10, 5), Rect(0, 0, 10, 5)},
+		{Rect(0, 0, 10, 5), V2(2, -3), Rect(2, -3, 12, 2), Rect(-2, 3, 8, 8)},
+		{Rect(1.5, 2.5, 3, 4), V2(0.5, 0.25), Rect(2, 2.75, 3.5, 4.25), Rect(1, 2.25, 2.5, 3.75)},
+	}
+	for _, tt := range tests {
+		if x := tt.rect.Add(tt.v); !rectangleNearEq(x, tt.add) {
+			t.Errorf("%v.Add(%v) was: %v, want: %v", tt.rect, tt.v, x, tt.add)
+		}
+		if x := tt.rect.Sub(tt.v); !rectangleNearEq(x, tt.sub) {
+			t.Errorf("%v.Sub(%v) was: %v, want: %v", tt.rect, tt.v, x, tt.sub)
+		}
+	}
+}
+
+func TestRectangleInset(t *testing.T) {
+	tests := []struct {
+		rect Rectangle
+		n    float32
+		want Rectangle
+	}{
+		{Rect(0, 0, 10, 5), 0, Rect(0, 0, 10, 5)},
+		{Rect(0, 0, 10, 5), 1, Rect(1, 1, 9, 4)},
+		{Rect(0, 0, 10, 5), -1.5, Rect(-1.5, -1.5, 11.5, 6.5)},
+		{Rect(0, 0, 10, 5), 3, Rect(3, 2.5, 7, 2.5)},
+		{Rect(0, 0, 10, 5), 6, Rect(5, 2.5, 5, 2.5)},
+	}
+	for _, tt := range tests {
+		if x := tt.rect.Inset(tt.n); !rectangleNearEq(x, tt.want) {
+			t.Errorf("%v.Inset(%g) was: %v, want: %v", tt.rect, tt.n, x, tt.want)
+		}
+	}
+}
+
+func TestRectangleIntersectUnion(t *testing.T) {
+	tests := []struct {
+		r, s      Rectangle
+		intersect Rectangle
+		union     Rectangle
+		overlaps  bool
+	}{
+		{Rect(0, 0, 10, 10), Rect(5, 5, 15, 15), Rect(5, 5, 10, 10), Rect(0, 0, 15, 15), true},
+		{Rect(0, 0, 10, 10), Rect(2, 3, 4, 5), Rect(2, 3, 4, 5), Rect(0, 0, 10, 10), true},
+		{Rect(0, 0, 10, 10), Rect(10, 0, 20, 10), Rect(10, 0, 10, 10), Rect(0, 0, 20, 10), true},
+		{Rect(0, 0, 10, 10), Rect(11, 0, 20, 10), Rect(11, 0, 10, 10), Rect(0, 0, 20, 10), false},
+		{Rect(0, 0, 10, 10), Rect(5, 5, 0, 0), Rect(5, 5, 0, 0), Rect(0, 0, 10, 10), false},
+		{Rect(5, 5, 0, 0), Rect(0, 0, 10, 10), Rect(5, 5, 0, 0), Rect(0, 0, 10, 10), false},
+	}
+	for _, tt := range tests {
+		if x := tt.r.Intersect(tt.s); !rectangleNearEq(x, tt.intersect) {
+			t.Errorf("%v.Intersect(%v) was: %v, want: %v", tt.r, tt.s, x, tt.intersect)
+		}
+		if x := tt.r.Union(tt.s); !rectangleNearEq(x, tt.union) {
+			t.Errorf("%v.Union(%v) was: %v, want: %v", tt.r, tt.s, x, tt.union)
+		}
+		if x := tt.r.Overlaps(tt.s); x != tt.overlaps {
+			t.Errorf("%v.Overlaps(%v) was: %v, want: %v", tt.r, tt.s, x, tt.overlaps)
+		}
+	}
+}
+
+func TestRectangleEmptyCanon(t *testing.T) {
+	tests := []struct {
+		rect  Rectangle
+		empty bool
+		canon Rectangle
+	}{
+		{Rect(0, 0, 10, 5), false, Rect(0, 0, 10, 5)},
+		{Rect(0, 0, 0, 0), false, Rect(0, 0, 0, 0)},
+		{Rect(10, 0, 0, 5), true, Rect(0, 0, 10, 5)},
+		{Rect(0, 5, 10, 0), true, Rect(0, 0, 10, 5)},
+		{Rect(10, 5, 0, 0), true, Rect(0, 0, 10, 5)},
+	}
+	for _, tt := range tests {
+		if x := tt.rect.Empty(); x != tt.empty {
+			t.Errorf("%v.Empty() was: %v, want: %v", tt.rect, x, tt.empty)
+		}
+		if x := tt.rect.Canon(); x != tt.canon {
+			t.Errorf("%v.Canon() was: %v, want: %v", tt.rect, x, tt.canon)
+		}
+	}
+}
+
+func TestRectangleEq(t *testing.T) {
+	tests := []struct {
+		r, s          Rectangle
+		eq, nearEqual bool
+	}{
+		{Rect(0, 0, 10, 5), Rect(0, 0, 10, 5), true, true},
+		{Rect(0, 0, 10, 5), Rect(0, 0, 10.000001, 5), false, true},
+		{Rect(0, 0, 10, 5), Rect(0, 0, 10, 6), false, false},
+		{Rect(10, 0, 0, 5), Rect(3, 3, 2, 2), true, false},
+	}
+	for _, tt := range tests {
+		if x := tt.r.Eq(tt.s); x != tt.eq {
+			t.Errorf("%v.Eq(%v) was: %v, want: %v", tt.r, tt.s, x, tt.eq)
+		}
+		if x := tt.r.NearEq(tt.s); x != tt.nearEqual {
+			t.Errorf("%v.NearEq(%v) was: %v, want: %v", tt.r, tt.s, x, tt.nearEqual)
+		}
+	}
+}
+
+func TestRectangleImageRect(t *testing.T) {
+	tests := []struct {
+		rect Rectangle
+		mode RoundingMode
+		want image.Rectangle
+	}{
+		{Rect(0, 0, 10, 5), RoundNearest, image.Rect(0, 0, 10, 5)},
+		{Rect(0.4, 0.5, 9.6, 5.5), RoundNearest, image.Rect(0, 1, 10, 6)},
+		{Rect(-0.5, -1.2, 9.6, 5.5), RoundDown, image.Rect(-1, -2, 9, 5)},
+		{Rect(-0.5, -1.2, 9.6, 5.5), RoundUp, image.Rect(0, -1, 10, 6)},
+		{Rect(-0.5, -1.2, 9.6, 5.5), RoundOut, image.Rect(-1, -2, 10, 6)},
+		{Rect(-0.5, -1.2, 9.6, 5.5), RoundIn, image.Rect(0, -1, 9, 5)},
+		{Rect(0.2, 0.2, 0.8, 0.8), RoundIn, image.Rectangle{Min: image.Pt(1, 1), Max: image.Pt(0, 0)}},
+	}
+	for _, tt := range tests {
+		if x := tt.rect.ImageRect(tt.mode); x != tt.want {
+			t.Errorf("%v.ImageRect(%v) was: %v, want: %v", tt.rect, tt.mode, x, tt.want)
+		}
+	}
+}
+
+func TestRectangleString(t *testing.T) {
+	tests := []struct {
+		rect Rectangle
+		want string
+	}{
+		{Rect(0, 0, 0, 0), "(0, 0)-(0, 0)"},
+		{Rect(3, 4, 6, 5.5), "(3, 4)-(6, 5.5)"},
+		{Rect(-2.3, 1.1, 12.72, -34.0213), "(-2.3, 1.1)-(12.72, -34.0213)"},
+	}
+	for _, tt := range tests {
+		if s := tt.rect.String(); s != tt.want {
+			t.Errorf("%#v.String() was: %q, want: %q", tt.rect, s, tt.want)
+		}
+	}
+}
+
+func TestRectangleNearEqTol(t *testing.T) {
+	r := Rect(0, 0, 1920, 1080)
+	s := Rect(0.001, 0, 1920.1, 1080)
+	if r.NearEq(s) {
+		t.Errorf("%s.NearEq(%s) = true, want false", r, s)
+	}
+	if !r.NearEqAbs(s, 0.5) || r.NearEqAbs(s, 0.01) {
+		t.Errorf("%s.NearEqAbs(%s, tol) gives wrong results", r, s)
+	}
+	// The relative tolerance fails for coordinates near zero.
+	if r.NearEqRel(s, 1e-3) {
+		t.Errorf("%s.NearEqRel(%s, 1e-3) = true, want false", r, s)
+	}
+	u := Rect(0, 0, math.Nextafter32(1920, 0), 1080)
+	if !r.NearEqULP(u, 1) || r.NearEqULP(s, 1) {
+		t.Errorf("%s.NearEqULP(ulps) gives wrong results", r)
+	}
+}
+
 func rectangleNearEq(a, b Rectangle) bool {
 	return a.Min.NearEq(b.Min) && a.Max.NearEq(b.Max)
 }
@@ -0,0 +1,164 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import "image"
+
+// A Recti is a rectangle of integer grid cells or pixels. Unlike Rectangle
+// it is half-open like image.Rectangle: it contains the cells with
+// Min.X <= X < Max.X, Min.Y <= Y < Max.Y. It is well-formed if
+// Min.X <= Max.X and likewise for Y. A Recti that is not well-formed or has
+// a width or height of zero is empty.
+type Recti struct {
+	Min Vec2i
+	Max Vec2i
+}
+
+// RectiFromImage converts the rectangle r of the image package to a Recti.
+func RectiFromImage(r image.Rectangle) Recti {
+	return Recti{Min: Vec2iFromPoint(r.Min), Max: Vec2iFromPoint(r.Max)}
+}
+
+// Contains reports whether the rectangle contains cell p.
+func (r Recti) Contains(p Vec2i) bool {
+	return (r.Min.X <= p.X && p.X < r.Max.X) &&
+		(r.Min.Y <= p.Y && p.Y < r.Max.Y)
+}
+
+// ContainsRect reports whether rectangle s is entirely contained in r.
+// An empty rectangle is contained in any rectangle.
+func (r Recti) ContainsRect(s Recti) bool {
+	if s.Empty() {
+		return true
+	}
+	return r.Min.X <= s.Min.X && s.Max.X <= r.Max.X &&
+		r.Min.Y <= s.Min.Y && s.Max.Y <= r.Max.Y
+}
+
+// Dx returns r's width.
+func (r Recti) Dx() int {
+	return r.Max.X - r.Min.X
+}
+
+// Dy returns r's height.
+func (r Recti) Dy() int {
+	return r.Max.Y - r.Min.Y
+}
+
+// Size returns r's width and height as a vector.
+func (r Recti) Size() Vec2i {
+	return r.Max.Sub(r.Min)
+}
+
+// Area returns the number of cells in r, or 0 if r is empty.
+func (r Recti) Area() int {
+	if r.Empty() {
+		return 0
+	}
+	return r.Dx() * r.Dy()
+}
+
+// Empty reports whether the rectangle contains no cells.
+func (r Recti) Empty() bool {
+	return r.Min.X >= r.Max.X || r.Min.Y >= r.Max.Y
+}
+
+// Canon returns the canonical version of r. The returned rectangle has
+// minimum and maximum coordinates swapped if necessary so that it is
+// well-formed.
+func (r Recti) Canon() Recti {
+	return Recti{Min: r.Min.Min(r.Max), Max: r.Min.Max(r.Max)}
+}
+
+// Intersect returns the largest rectangle contained by both r and s. If the
+// two rectangles do not overlap then the zero rectangle will be returned.
+func (r Recti) Intersect(s Recti) Recti {
+	i := Recti{Min: r.Min.Max(s.Min), Max: r.Max.Min(s.Max)}
+	if i.Empty() {
+		return Recti{}
+	}
+	return i
+}
+
+// Union returns the smallest rectangle that contains both r and s. If one
+// of them is empty, the other one is returned.
+func (r Recti) Union(s Recti) Recti {
+	if r.Empty() {
+		return s
+	}
+	if s.Empty() {
+		return r
+	}
+	return Recti{Min: r.Min.Min(s.Min), Max: r.Max.Max(s.Max)}
+}
+
+// Overlaps reports whether r and s have a non-empty intersection.
+func (r Recti) Overlaps(s Recti) bool {
+	return !r.Intersect(s).Empty()
+}
+
+// Add returns the rectangle r translated by v.
+func (r Recti) Add(v Vec2i) Recti {
+	return Recti{Min: r.Min.Add(v), Max: r.Max.Add(v)}
+}
+
+// Sub returns the rectangle r translated by -v.
+func (r Recti) Sub(v Vec2i) Recti {
+	return Recti{Min: r.Min.Sub(v), Max: r.Max.Sub(v)}
+}
+
+// Inset returns the rectangle r inset by n, which may be negative. If
+// either of r's dimensions is less than 2*n then an empty rectangle near
+// the center of r will be returned.
+func (r Recti) Inset(n int) Recti {
+	if r.Dx() < 2*n {
+		r.Min.X = (r.Min.X + r.Max.X) / 2
+		r.Max.X = r.Min.X
+	} else {
+		r.Min.X += n
+		r.Max.X -= n
+	}
+	if r.Dy() < 2*n {
+		r.Min.Y = (r.Min.Y + r.Max.Y) / 2
+		r.Max.Y = r.Min.Y
+	} else {
+		r.Min.Y += n
+		r.Max.Y -= n
+	}
+	return r
+}
+
+// Cells calls yield for each cell contained in r in row-major order, i.e.
+// X varies fastest, until yield returns false. It does not call yield if r
+// is empty. With Go 1.23 or later the method value can be used as an
+// iterator:
+//
+//	for c := range r.Cells {
+//		// ...
+//	}
+func (r Recti) Cells(yield func(Vec2i) bool) {
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			if !yield(Vec2i{x, y}) {
+				return
+			}
+		}
+	}
+}
+
+// Rect returns the area covered by the cells of r as a Rectangle.
+func (r Recti) Rect() Rectangle {
+	return Rectangle{Min: r.Min.Vec2(), Max: r.Max.Vec2()}
+}
+
+// Image returns r converted to a rectangle of the image package.
+func (r Recti) Image() image.Rectangle {
+	return image.Rectangle{Min: r.Min.Point(), Max: r.Max.Point()}
+}
+
+// String returns a string representation of r like "(3, 4)-(6, 5)".
+func (r Recti) String() string {
+	return r.Min.String() + "-" + r.Max.String()
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"image"
+	"slices"
+	"testing"
+)
+
+func TestRectiContains(t *testing.T) {
+	r := Recti{V2i(-1, 0), V2i(2, 3)}
+	tests := []struct {
+		p    Vec2i
+		want bool
+	}{
+		{V2i(-1, 0), true},
+		{V2i(1, 2), true},
+		{V2i(2, 2), false},
+		{V2i(1, 3), false},
+		{V2i(-2, 1), false},
+	}
+	for _, tt := range tests {
+		if x := r.Contains(tt.p); x != tt.want {
+			t.Errorf("%s.Contains(%s) = %v, want %v", r, tt.p, x, tt.want)
+		}
+	}
+	if !r.ContainsRect(Recti{V2i(0, 1), V2i(2, 3)}) {
+		t.Errorf("%s.ContainsRect((0, 1)-(2, 3)) = false, want true", r)
+	}
+	if r.ContainsRect(Recti{V2i(0, 1), V2i(3, 3)}) {
+		t.Errorf("%s.ContainsRect((0, 1)-(3, 3)) = true, want false", r)
+	}
+	if !r.ContainsRect(Recti{V2i(5, 5), V2i(5, 9)}) {
+		t.Errorf("%s.ContainsRect((5, 5)-(5, 9)) = false, want true", r)
+	}
+}
+
+func TestRectiSize(t *testing.T) {
+	tests := []struct {
+		r     Recti
+		size  Vec2i
+		area  int
+		empty bool
+	}{
+		{Recti{V2i(-1, 0), V2i(2, 3)}, V2i(3, 3), 9, false},
+		{Recti{V2i(0, 0), V2i(4, 1)}, V2i(4, 1), 4, false},
+		{Recti{V2i(0, 0), V2i(4, 0)}, V2i(4, 0), 0, true},
+		{Recti{V2i(2, 0), V2i(0, 2)}, V2i(-2, 2), 0, true},
+	}
+	for _, tt := range tests {
+		if x := tt.r.Size(); x != tt.size || tt.r.Dx() != tt.size.X || tt.r.Dy() != tt.size.Y {
+			t.Errorf("%s.Size() = %s, want %s", tt.r, x, tt.size)
+		}
+		if x := tt.r.Area(); x != tt.area {
+			t.Errorf("%s.Area() = %d, want %d", tt.r, x, tt.area)
+		}
+		if x := tt.r.Empty(); x != tt.empty {
+			t.Errorf("%s.Empty() = %v, want %v", tt.r, x, tt.empty)
+		}
+	}
+}
+
+func TestRectiSetOps(t *testing.T) {
+	tests := []struct {
+		r, s      Recti
+		intersect Recti
+		union     Recti
+		overlaps  bool
+	}{
+		{
+			Recti{V2i(0, 0), V2i(4, 4)}, Recti{V2i(2, 1), V2i(6, 3)},
+			Recti{V2i(2, 1), V2i(4, 3)}, Recti{V2i(0, 0), V2i(6, 4)}, true,
+		},
+		{
+			// Touching edges do not share cells.
+			Recti{V2i(0, 0), V2i(2, 2)}, Recti{V2i(2, 0), V2i(4, 2)},
+			Recti{}, Recti{V2i(0, 0), V2i(4, 2)}, false,
+		},
+		{
+			Recti{V2i(0, 0), V2i(2, 2)}, Recti{V2i(5, 5), V2i(5, 5)},
+			Recti{}, Recti{V2i(0, 0), V2i(2, 2)}, false,
+		},
+	}
+	for _, tt := range tests {
+		if x := tt.r.Intersect(tt.s); x != tt.intersect {
+			t.Errorf("%s.Intersect(%s) = %s, want %s", tt.r, tt.s, x, tt.intersect)
+		}
+		if x := tt.r.Union(tt.s); x != tt.union {
+			t.Errorf("%s.Union(%s) = %s, want %s", tt.r, tt.s, x, tt.union)
+		}
+		if x := tt.r.Overlaps(tt.s); x != tt.overlaps {
+			t.Errorf("%s.Overlaps(%s) = %v, want %v", tt.r, tt.s, x, tt.overlaps)
+		}
+	}
+}
+
+func TestRectiTransforms(t *testing.T) {
+	r := Recti{V2i(1, 2), V2i(5, 4)}
+	if x := r.Add(V2i(2, -1)); x != (Recti{V2i(3, 1), V2i(7, 3)}) {
+		t.Errorf("%s.Add((2, -1)) = %s", r, x)
+	}
+	if x := r.Sub(V2i(2, -1)); x != (Recti{V2i(-1, 3), V2i(3, 5)}) {
+		t.Errorf("%s.Sub((2, -1)) = %s", r, x)
+	}
+	if x := r.Inset(1); x != (Recti{V2i(2, 3), V2i(4, 3)}) {
+		t.Errorf("%s.Inset(1) = %s", r, x)
+	}
+	if x := r.Inset(-1); x != (Recti{V2i(0, 1), V2i(6, 5)}) {
+		t.Errorf("%s.Inset(-1) = %s", r, x)
+	}
+	if x := r.Inset(2); x != (Recti{V2i(3, 3), V2i(3, 3)}) {
+		t.Errorf("%s.Inset(2) = %s", r, x)
+	}
+	if x := (Recti{V2i(5, 4), V2i(1, 2)}).Canon(); x != r {
+		t.Errorf("Canon() = %s, want %s", x, r)
+	}
+}
+
+// collect returns the values passed by cells to its callback.
+func collect[V any](cells func(yield func(V) bool)) []V {
+	var s []V
+	cells(func(v V) bool {
+		s = append(s, v)
+		return true
+	})
+	return s
+}
+
+func TestRectiCells(t *testing.T) {
+	r := Recti{V2i(1, 2), V2i(3, 4)}
+	want := []Vec2i{{1, 2}, {2, 2}, {1, 3}, {2, 3}}
+	if x := collect(r.Cells); !slices.Equal(x, want) {
+		t.Errorf("%s.Cells() = %v, want %v", r, x, want)
+	}
+	if x := collect(Recti{V2i(3, 4), V2i(1, 2)}.Cells); len(x) != 0 {
+		t.Errorf("Cells() of empty rectangle = %v, want none", x)
+	}
+	n := 0
+	r.Cells(func(c Vec2i) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("Cells() called yield %d times after it returned false, want 1", n)
+	}
+}
+
+func TestRectiConversions(t *testing.T) {
+	r := Recti{V2i(1, 2), V2i(3, 4)}
+	if x := r.Rect(); x != Rect(1, 2, 3, 4) {
+		t.Errorf("%s.Rect() = %s, want %s", r, x, Rect(1, 2, 3, 4))
+	}
+	if x := r.Image(); x != image.Rect(1, 2, 3, 4) {
+		t.Errorf("%s.Image() = %v, want %v", r, x, image.Rect(1, 2, 3, 4))
+	}
+	if x := RectiFromImage(image.Rect(1, 2, 3, 4)); x != r {
+		t.Errorf("RectiFromImage(%v) = %s, want %s", image.Rect(1, 2, 3, 4), x, r)
+	}
+	if s := r.String(); s != "(1, 2)-(3, 4)" {
+		t.Errorf("String() = %q, want %q", s, "(1, 2)-(3, 4)")
+	}
+}
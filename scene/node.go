@@ -0,0 +1,168 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package scene provides a hierarchy of nodes with transformations relative
+// to their parents, as used by scene graphs.
+//
+// The world transformation matrices of the nodes are computed lazily and
+// cached. Changing the local transformation of a node only marks the node
+// and its descendants as dirty, so that the matrices of the parts of the
+// scene that did not move are not recomputed.
+package scene // import "github.com/fzipp/geom/scene"
+
+import (
+	"slices"
+
+	"github.com/fzipp/geom"
+)
+
+// A Node is a node of a scene hierarchy. It has a transformation relative
+// to its parent, or to the world if it has no parent. Create it with
+// NewNode.
+type Node struct {
+	local    geom.Transform
+	parent   *Node
+	children []*Node
+
+	// The world matrix and its inverse are valid unless the corresponding
+	// dirty flag is set. If a node is dirty, all of its descendants are
+	// dirty as well.
+	world    geom.Mat4
+	worldInv geom.Mat4
+	dirty    bool
+	invDirty bool
+}
+
+// NewNode returns a new node without parent with the local transformation
+// local.
+func NewNode(local geom.Transform) *Node {
+	return &Node{local: local, dirty: true, invDirty: true}
+}
+
+// Local returns the transformation of n relative to its parent.
+func (n *Node) Local() geom.Transform {
+	return n.local
+}
+
+// SetLocal sets the transformation of n relative to its parent, which
+// invalidates the world matrices of n and its descendants.
+func (n *Node) SetLocal(t geom.Transform) {
+	n.local = t
+	n.invalidate()
+}
+
+// invalidate marks n and its descendants as dirty.
+func (n *Node) invalidate() {
+	if n.dirty {
+		return
+	}
+	n.dirty, n.invDirty = true, true
+	for _, c := range n.children {
+		c.invalidate()
+	}
+}
+
+// Parent returns the parent of n, or nil if n is a root node.
+func (n *Node) Parent() *Node {
+	return n.parent
+}
+
+// Children returns the children of n in the order they were added. The
+// slice must not be modified.
+func (n *Node) Children() []*Node {
+	return n.children
+}
+
+// SetParent makes n a child of p, removing it from its previous parent,
+// and keeps its local transformation, so that n moves with its new parent.
+// If p is nil, n becomes a root node. If p is already the parent of n,
+// SetParent does nothing. SetParent panics if p is n or one of its
+// descendants.
+func (n *Node) SetParent(p *Node) {
+	if p == n.parent {
+		return
+	}
+	for a := p; a != nil; a = a.parent {
+		if a == n {
+			panic("scene: node cannot be a descendant of itself")
+		}
+	}
+	if n.parent != nil {
+		siblings := n.parent.children
+		i := slices.Index(siblings, n)
+		n.parent.children = slices.Delete(siblings, i, i+1)
+	}
+	n.parent = p
+	if p != nil {
+		p.children = append(p.children, n)
+	}
+	n.invalidate()
+}
+
+// Reparent makes n a child of p like SetParent, but adjusts the local
+// transformation of n so that its world transformation is preserved. It
+// reports whether this is possible. It is not if the world transformation
+// of p or n is degenerate, e.g. with a zero scale, or if the new local
+// transformation would require a shear, e.g. if p has a non-uniform scale
+// and n is rotated relative to it. In this case Reparent leaves the
+// hierarchy and the local transformation of n unchanged.
+func (n *Node) Reparent(p *Node) bool {
+	local := *n.worldMatrix()
+	if p != nil {
+		var inv geom.Mat4
+		if !inv.TryInv(p.worldMatrix()) {
+			return false
+		}
+		local.Mul(&inv, &local)
+	}
+	d, ok := local.Decompose()
+	if !ok || !d.Shear.NearEq(geom.Vec3{}) {
+		return false
+	}
+	n.SetParent(p)
+	n.SetLocal(geom.Transform{Translation: d.Translation, Rotation: d.Rotation, Scale: d.Scale})
+	return true
+}
+
+// World returns the transformation matrix from the local coordinate space
+// of n to world coordinates, which is the product of the local
+// transformation matrices of n and its ancestors. It is only recomputed if
+// the transformation of n or one of its ancestors has changed.
+func (n *Node) World() geom.Mat4 {
+	return *n.worldMatrix()
+}
+
+// worldMatrix returns a pointer to the valid world matrix of n.
+func (n *Node) worldMatrix() *geom.Mat4 {
+	if n.dirty {
+		n.world = n.local.Mat4()
+		if n.parent != nil {
+			n.world.Mul(n.parent.worldMatrix(), &n.world)
+		}
+		n.dirty = false
+	}
+	return &n.world
+}
+
+// worldInverse returns a pointer to the inverse of the world matrix of n.
+func (n *Node) worldInverse() *geom.Mat4 {
+	if n.dirty || n.invDirty {
+		n.worldInv.Inv(n.worldMatrix())
+		n.invDirty = false
+	}
+	return &n.worldInv
+}
+
+// LocalToWorld converts the point v from the local coordinate space of n
+// to world coordinates.
+func (n *Node) LocalToWorld(v geom.Vec3) geom.Vec3 {
+	return v.Transform(n.worldMatrix())
+}
+
+// WorldToLocal converts the point v from world coordinates to the local
+// coordinate space of n. If the world transformation of n is degenerate,
+// the result is infinite or NaN.
+func (n *Node) WorldToLocal(v geom.Vec3) geom.Vec3 {
+	return v.Transform(n.worldInverse())
+}
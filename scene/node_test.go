@@ -0,0 +1,120 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package scene
+
+import (
+	"testing"
+
+	"github.com/fzipp/geom"
+)
+
+func trs(t geom.Vec3, angle float32, axis geom.Vec3, s geom.Vec3) geom.Transform {
+	return geom.Transform{Translation: t, Rotation: geom.QuatRot(angle, axis.Norm()), Scale: s}
+}
+
+func TestNodeWorld(t *testing.T) {
+	root := NewNode(trs(geom.V3(10, 0, 0), geom.Rad(90), geom.V3(0, 1, 0), geom.V3(2, 2, 2)))
+	arm := NewNode(trs(geom.V3(0, 1, 0), geom.Rad(30), geom.V3(1, 0, 0), geom.V3(1, 1, 1)))
+	hand := NewNode(trs(geom.V3(0, 0, 3), 0, geom.V3(0, 0, 1), geom.V3(0.5, 0.5, 0.5)))
+	arm.SetParent(root)
+	hand.SetParent(arm)
+
+	check := func() {
+		t.Helper()
+		rm, am, hm := root.Local().Mat4(), arm.Local().Mat4(), hand.Local().Mat4()
+		var want geom.Mat4
+		want.Mul(&rm, &am)
+		want.Mul(&want, &hm)
+		if w := hand.World(); !w.NearEq(&want) {
+			t.Errorf("hand.World() = %v, want %v", w, want)
+		}
+		for _, v := range []geom.Vec3{geom.V3(0, 0, 0), geom.V3(1, 2, 3)} {
+			w := v.Transform(&want)
+			if x := hand.LocalToWorld(v); !x.NearEq(w) {
+				t.Errorf("hand.LocalToWorld(%s) = %s, want %s", v, x, w)
+			}
+			if x := hand.WorldToLocal(w); !x.NearEq(v) {
+				t.Errorf("hand.WorldToLocal(%s) = %s, want %s", w, x, v)
+			}
+		}
+	}
+	check()
+	if root.dirty || arm.dirty || hand.dirty || hand.invDirty {
+		t.Errorf("nodes are dirty after computing world matrices")
+	}
+
+	// Changing a transformation invalidates only the subtree.
+	arm.SetLocal(trs(geom.V3(0, 2, 0), geom.Rad(-45), geom.V3(1, 0, 1), geom.V3(1, 3, 1)))
+	if root.dirty || !arm.dirty || !hand.dirty {
+		t.Errorf("dirty flags after SetLocal: root %t, arm %t, hand %t, want false, true, true",
+			root.dirty, arm.dirty, hand.dirty)
+	}
+	check()
+	root.SetLocal(geom.TransformID)
+	check()
+}
+
+func TestNodeParent(t *testing.T) {
+	a, b, c := NewNode(geom.TransformID), NewNode(geom.TransformID), NewNode(geom.TransformID)
+	b.SetParent(a)
+	c.SetParent(a)
+	if b.Parent() != a || len(a.Children()) != 2 || a.Children()[0] != b || a.Children()[1] != c {
+		t.Fatalf("unexpected hierarchy after SetParent")
+	}
+	b.World()
+	b.SetParent(a)
+	if len(a.Children()) != 2 || a.Children()[0] != b || a.Children()[1] != c || b.dirty {
+		t.Errorf("SetParent with the current parent changed the order of the children %v or invalidated b",
+			a.Children())
+	}
+	c.SetParent(b)
+	if c.Parent() != b || len(a.Children()) != 1 || len(b.Children()) != 1 || b.Children()[0] != c {
+		t.Errorf("unexpected hierarchy after moving c from a to b")
+	}
+	c.SetParent(nil)
+	if c.Parent() != nil || len(b.Children()) != 0 {
+		t.Errorf("unexpected hierarchy after making c a root node")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("a.SetParent(b) with b a child of a did not panic")
+		}
+	}()
+	a.SetParent(b)
+}
+
+func TestNodeReparent(t *testing.T) {
+	p1 := NewNode(trs(geom.V3(5, 0, 0), geom.Rad(90), geom.V3(0, 0, 1), geom.V3(2, 2, 2)))
+	p2 := NewNode(trs(geom.V3(0, -3, 1), geom.Rad(-60), geom.V3(1, 1, 0), geom.V3(0.5, 0.5, 0.5)))
+	n := NewNode(trs(geom.V3(1, 2, 3), geom.Rad(20), geom.V3(0, 1, 0), geom.V3(1, 2, 3)))
+	n.SetParent(p1)
+	want := n.World()
+
+	for _, p := range []*Node{p2, nil, p1} {
+		if !n.Reparent(p) {
+			t.Errorf("Reparent failed")
+		}
+		if n.Parent() != p {
+			t.Errorf("Parent() after Reparent is %p, want %p", n.Parent(), p)
+		}
+		if w := n.World(); !w.NearEq(&want) {
+			t.Errorf("World() after Reparent = %v, want %v", w, want)
+		}
+	}
+
+	// A failed Reparent leaves the node unchanged.
+	flat := NewNode(geom.Transform{Rotation: geom.QuatID, Scale: geom.V3(1, 0, 1)})
+	stretched := NewNode(geom.Transform{Rotation: geom.QuatID, Scale: geom.V3(1, 4, 1)})
+	parent, local := n.Parent(), n.Local()
+	for _, p := range []*Node{flat, stretched} {
+		if n.Reparent(p) {
+			t.Errorf("Reparent to %s succeeded, want failure", p.Local())
+		}
+		if n.Parent() != parent || n.Local() != local || len(p.Children()) != 0 {
+			t.Errorf("Reparent to %s: parent %p, local %s, want %p, %s", p.Local(), n.Parent(), n.Local(), parent, local)
+		}
+	}
+}
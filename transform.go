@@ -0,0 +1,126 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+// A Transformation is a 3-dimensional transformation given by a
+// translation, a rotation and a non-uniform scale, which are applied in the
+// reverse order: scale first, then rotation, then translation. Unlike a
+// Matrix4 its components can be edited and interpolated directly. The
+// rotation must be a unit quaternion. The zero value is not a valid
+// transformation; start with TransformationID or TransformID instead.
+type Transformation[T Float] struct {
+	Translation Vector3[T]
+	Rotation    Quaternion[T]
+	Scale       Vector3[T]
+}
+
+// A Transform is a transformation with float32 components.
+type Transform = Transformation[float32]
+
+// A Transformd is a transformation with float64 components.
+type Transformd = Transformation[float64]
+
+// TransformationID returns the identity transformation, which has no
+// translation, no rotation and a scale of 1.
+func TransformationID[T Float]() Transformation[T] {
+	return Transformation[T]{Rotation: Quaternion[T]{0, 0, 0, 1}, Scale: Vector3[T]{1, 1, 1}}
+}
+
+// TransformID is the identity transformation with float32 components.
+var TransformID = TransformationID[float32]()
+
+// TransformFromMat4 returns the transformation of the matrix m and reports
+// whether m could be decomposed into a translation, a rotation and a scale.
+// Shear and perspective components of m are ignored, see Matrix4.Decompose.
+func TransformFromMat4[T Float](m *Matrix4[T]) (Transformation[T], bool) {
+	d, ok := m.Decompose()
+	return Transformation[T]{Translation: d.Translation, Rotation: d.Rotation, Scale: d.Scale}, ok
+}
+
+// Point returns the point v transformed by t.
+func (t Transformation[T]) Point(v Vector3[T]) Vector3[T] {
+	return t.Rotation.Rotate(v.CompMul(t.Scale)).Add(t.Translation)
+}
+
+// Dir returns the direction vector v transformed by t, i.e. without the
+// translation.
+func (t Transformation[T]) Dir(v Vector3[T]) Vector3[T] {
+	return t.Rotation.Rotate(v.CompMul(t.Scale))
+}
+
+// Mul returns the composition t*u, which applies u first and then t, e.g.
+// the transformation of a child node relative to the world if t is the
+// transformation of its parent and u the one of the child relative to the
+// parent. The result is exact if the scale of t is uniform. Otherwise the
+// composition may contain a shear, which cannot be represented by a
+// Transformation, and the scale is approximated by the product of the
+// scales.
+func (t Transformation[T]) Mul(u Transformation[T]) Transformation[T] {
+	return Transformation[T]{
+		Translation: t.Point(u.Translation),
+		Rotation:    t.Rotation.Mul(u.Rotation),
+		Scale:       t.Scale.CompMul(u.Scale),
+	}
+}
+
+// Inv returns the inverse transformation of t, so that t.Inv().Point
+// maps t.Point(v) back to v. This holds only if the scale of t is uniform
+// or if t has no rotation; otherwise the exact inverse would apply the
+// scale after the rotation, which a Transformation cannot represent, and
+// the result is an approximation. The scale must not have zero components.
+func (t Transformation[T]) Inv() Transformation[T] {
+	r := t.Rotation.Conj()
+	s := Vector3[T]{1 / t.Scale.X, 1 / t.Scale.Y, 1 / t.Scale.Z}
+	return Transformation[T]{
+		Translation: r.Rotate(t.Translation).CompMul(s).Neg(),
+		Rotation:    r,
+		Scale:       s,
+	}
+}
+
+// Lerp returns the interpolation between the transformations t and u by
+// amount x, e.g. for blending animations. The translations and scales are
+// interpolated linearly, and the rotations spherically with Slerp.
+func (t Transformation[T]) Lerp(u Transformation[T], x T) Transformation[T] {
+	return Transformation[T]{
+		Translation: t.Translation.Lerp(u.Translation, x),
+		Rotation:    t.Rotation.Slerp(u.Rotation, x),
+		Scale:       t.Scale.Lerp(u.Scale, x),
+	}
+}
+
+// Mat4 returns the matrix of the transformation t, which is the same as
+// the one built with Translate, RotQuat and Scale in this order.
+func (t Transformation[T]) Mat4() Matrix4[T] {
+	var m Matrix4[T]
+	m.Compose(Decomposition[T]{Translation: t.Translation, Rotation: t.Rotation, Scale: t.Scale})
+	return m
+}
+
+// NearEq returns whether t and u are approximately equal. This relation is
+// not transitive in general. The tolerance for the floating-point
+// components is ±1e-5. The rotations are compared like with
+// Quaternion.NearEq.
+func (t Transformation[T]) NearEq(u Transformation[T]) bool {
+	return t.Translation.NearEq(u.Translation) &&
+		t.Rotation.NearEq(u.Rotation) &&
+		t.Scale.NearEq(u.Scale)
+}
+
+// String returns a string representation of t like
+// "T(1, 2, 3) R(0, 0, 0, 1) S(1, 1, 1)".
+func (t Transformation[T]) String() string {
+	return "T" + t.Translation.String() + " R" + t.Rotation.String() + " S" + t.Scale.String()
+}
+
+// Float32 returns t converted to float32 components.
+func (t Transformation[T]) Float32() Transform {
+	return Transform{t.Translation.Float32(), t.Rotation.Float32(), t.Scale.Float32()}
+}
+
+// Float64 returns t converted to float64 components.
+func (t Transformation[T]) Float64() Transformd {
+	return Transformd{t.Translation.Float64(), t.Rotation.Float64(), t.Scale.Float64()}
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import "testing"
+
+func TestTransformPointDirMat4(t *testing.T) {
+	tr := Transform{
+		Translation: V3(3, -1, 2),
+		Rotation:    QuatRot(Rad(50), V3(1, -2, 0.5).Norm()),
+		Scale:       V3(2, 1, 0.5),
+	}
+	var want Mat4
+	want.Translate(&id, tr.Translation)
+	want.RotQuat(&want, tr.Rotation)
+	want.Scale(&want, tr.Scale)
+	m := tr.Mat4()
+	if !m.NearEq(&want) {
+		t.Errorf("%s.Mat4() = %v, want %v", tr, m, want)
+	}
+	for _, v := range []Vec3{V3(0, 0, 0), V3(1, 2, 3), V3(-4, 0.5, 1)} {
+		if x, w := tr.Point(v), v.Transform(&m); !x.NearEq(w) {
+			t.Errorf("%s.Point(%s) = %s, want %s", tr, v, x, w)
+		}
+		if x, w := tr.Dir(v), v.TransformDir(&m); !x.NearEq(w) {
+			t.Errorf("%s.Dir(%s) = %s, want %s", tr, v, x, w)
+		}
+	}
+	if x := TransformID.Point(V3(1, 2, 3)); x != V3(1, 2, 3) {
+		t.Errorf("TransformID.Point((1, 2, 3)) = %s, want (1, 2, 3)", x)
+	}
+
+	back, ok := TransformFromMat4(&m)
+	if !ok || !back.Translation.NearEq(tr.Translation) || !back.Scale.NearEq(tr.Scale) ||
+		!back.Rotation.Rotate(V3(1, 2, 3)).NearEq(tr.Rotation.Rotate(V3(1, 2, 3))) {
+		t.Errorf("TransformFromMat4(%v) = %s, %t, want %s, true", m, back, ok, tr)
+	}
+}
+
+func TestTransformMulInv(t *testing.T) {
+	parent := Transform{
+		Translation: V3(10, 0, -5),
+		Rotation:    QuatRot(Rad(90), V3(0, 1, 0)),
+		Scale:       V3(2, 2, 2),
+	}
+	child := Transform{
+		Translation: V3(1, 2, 3),
+		Rotation:    QuatRot(Rad(-30), V3(1, 0, 0)),
+		Scale:       V3(1, 3, 0.5),
+	}
+	world := parent.Mul(child)
+	pm, cm := parent.Mat4(), child.Mat4()
+	var wm Mat4
+	wm.Mul(&pm, &cm)
+	for _, v := range []Vec3{V3(0, 0, 0), V3(1, 2, 3), V3(-4, 0.5, 1)} {
+		if x, w := world.Point(v), parent.Point(child.Point(v)); !x.NearEq(w) {
+			t.Errorf("%s.Point(%s) = %s, want %s", world, v, x, w)
+		}
+		if x, w := world.Point(v), v.Transform(&wm); !x.NearEq(w) {
+			t.Errorf("%s.Point(%s) = %s, want %s as with matrices", world, v, x, w)
+		}
+	}
+
+	// The inverse is exact for uniform scales or without rotation.
+	scaled := Transform{Translation: V3(1, 2, 3), Rotation: QuatID, Scale: V3(1, 3, 0.5)}
+	for _, tr := range []Transform{parent, scaled} {
+		inv := tr.Inv()
+		for _, v := range []Vec3{V3(0, 0, 0), V3(1, 2, 3)} {
+			if x := inv.Point(tr.Point(v)); !x.NearEq(v) {
+				t.Errorf("%s.Inv().Point(%s.Point(%s)) = %s, want %s", tr, tr, v, x, v)
+			}
+			if x := tr.Point(inv.Point(v)); !x.NearEq(v) {
+				t.Errorf("%s.Point(%s.Inv().Point(%s)) = %s, want %s", tr, tr, v, x, v)
+			}
+		}
+	}
+}
+
+func TestTransformLerp(t *testing.T) {
+	a := Transform{Translation: V3(0, 0, 0), Rotation: QuatID, Scale: V3(1, 1, 1)}
+	b := Transform{Translation: V3(2, 4, -6), Rotation: QuatRot(Rad(90), V3(0, 0, 1)), Scale: V3(3, 1, 0)}
+	tests := []struct {
+		x    float32
+		want Transform
+	}{
+		{0, a},
+		{1, b},
+		{0.5, Transform{Translation: V3(1, 2, -3), Rotation: QuatRot(Rad(45), V3(0, 0, 1)), Scale: V3(2, 1, 0.5)}},
+	}
+	for _, tt := range tests {
+		if x := a.Lerp(b, tt.x); !x.NearEq(tt.want) {
+			t.Errorf("%s.Lerp(%s, %g) = %s, want %s", a, b, tt.x, x, tt.want)
+		}
+	}
+}
+
+func TestTransformString(t *testing.T) {
+	want := "T(0, 0, 0) R(0, 0, 0, 1) S(1, 1, 1)"
+	if s := TransformID.String(); s != want {
+		t.Errorf("TransformID.String() = %q, want %q", s, want)
+	}
+	if x := TransformID.Float64().Float32(); x != TransformID {
+		t.Errorf("TransformID.Float64().Float32() = %s, want %s", x, TransformID)
+	}
+	if x, want := TransformationID[float64](), TransformID.Float64(); x != want {
+		t.Errorf("TransformationID[float64]() = %s, want %s", x, want)
+	}
+}
@@ -7,12 +7,19 @@ package geom
 import (
 	"math"
 	"strconv"
+	"unsafe"
 )
 
+// Float is the constraint for the component types of the generic vector,
+// matrix and quaternion types.
+type Float interface {
+	~float32 | ~float64
+}
+
 // lerp returns the linear interpolation between a and b by amount t.
 // The amount t is usually a value between 0 and 1. If t=0 a will be returned;
 // if t=1 b will be returned.
-func lerp(a, b, t float32) float32 {
+func lerp[T Float](a, b, t T) T {
 	return a + (b-a)*t
 }
 
@@ -21,13 +28,80 @@ const epsilon = 1e-5
 // nearEq compares two floating-point numbers for equality within an
 // absolute difference tolerance of epsilon.
 // This relation is not transitive, except for ε=0.
-func nearEq(a, b, ε float32) bool {
-	return float32(math.Abs(float64(a-b))) <= ε
+func nearEq[T Float](a, b, ε T) bool {
+	return T(math.Abs(float64(a-b))) <= ε
+}
+
+// NearEqAbs reports whether the floating-point numbers a and b differ by at
+// most the absolute tolerance tol. This is suitable for values of a known
+// magnitude, in particular for values near zero. This relation is not
+// transitive, except for tol=0.
+func NearEqAbs[T Float](a, b, tol T) bool {
+	return a == b || T(math.Abs(float64(a-b))) <= tol
+}
+
+// NearEqRel reports whether the floating-point numbers a and b differ by at
+// most the relative tolerance tol, i.e. by tol times the greater of their
+// magnitudes. This is suitable for values of any magnitude, except for
+// values near zero, which are only equal to zero itself. This relation is
+// not transitive, except for tol=0.
+func NearEqRel[T Float](a, b, tol T) bool {
+	if a == b {
+		return true
+	}
+	d := math.Abs(float64(a - b))
+	m := max(math.Abs(float64(a)), math.Abs(float64(b)))
+	return T(d) <= tol*T(m)
+}
+
+// NearEqULP reports whether the floating-point numbers a and b are at most
+// ulps units in the last place apart, i.e. whether there are at most ulps-1
+// representable values of type T between them. Positive and negative zero
+// are equal. NaN is not equal to anything.
+func NearEqULP[T Float](a, b T, ulps uint) bool {
+	if a == b {
+		return true
+	}
+	if a != a || b != b {
+		// NaN
+		return false
+	}
+	ia, ib := orderedBits(a), orderedBits(b)
+	if ia < ib {
+		ia, ib = ib, ia
+	}
+	return uint64(ia)-uint64(ib) <= uint64(ulps)
+}
+
+// orderedBits returns the bit representation of f as an integer that is
+// ordered like the floating-point values, with both zeros mapped to 0.
+func orderedBits[T Float](f T) int64 {
+	if unsafe.Sizeof(f) == 4 {
+		i := int64(int32(math.Float32bits(float32(f))))
+		if i < 0 {
+			i = math.MinInt32 - i
+		}
+		return i
+	}
+	i := int64(math.Float64bits(float64(f)))
+	if i < 0 {
+		i = math.MinInt64 - i
+	}
+	return i
+}
+
+// machineEpsilon returns the difference between 1 and the next larger
+// number of type T.
+func machineEpsilon[T Float]() T {
+	if unsafe.Sizeof(T(0)) == 4 {
+		return 0x1p-23
+	}
+	return 0x1p-52
 }
 
-// str converts a float32 to a string in "%g" format.
-func str(f float32) string {
-	return strconv.FormatFloat(float64(f), 'g', -1, 32)
+// str converts a floating-point number to a string in "%g" format.
+func str[T Float](f T) string {
+	return strconv.FormatFloat(float64(f), 'g', -1, int(unsafe.Sizeof(f))*8)
 }
 
 // x is the radians<->degrees conversion factor.
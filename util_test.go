@@ -4,7 +4,10 @@
 
 package geom
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestDegRad(t *testing.T) {
 	tests := []struct {
@@ -22,3 +25,87 @@ func TestDegRad(t *testing.T) {
 		}
 	}
 }
+
+func TestNearEqAbs(t *testing.T) {
+	tests := []struct {
+		a, b, tol float32
+		want      bool
+	}{
+		{1, 1, 0, true},
+		{1, 1.5, 0.5, true},
+		{1, 1.6, 0.5, false},
+		{0, -1e-7, 1e-6, true},
+		{10000, 10000.001, 1e-5, false},
+		{float32(math.Inf(1)), float32(math.Inf(1)), 0, true},
+		{float32(math.NaN()), float32(math.NaN()), 1, false},
+	}
+	for _, tt := range tests {
+		if x := NearEqAbs(tt.a, tt.b, tt.tol); x != tt.want {
+			t.Errorf("NearEqAbs(%g, %g, %g) = %v, want %v", tt.a, tt.b, tt.tol, x, tt.want)
+		}
+	}
+}
+
+func TestNearEqRel(t *testing.T) {
+	tests := []struct {
+		a, b, tol float32
+		want      bool
+	}{
+		{1, 1, 0, true},
+		{10000, 10000.001, 1e-6, true},
+		{10000, 10001, 1e-6, false},
+		{1e-5, 1.00001e-5, 2e-5, true},
+		{1e-5, 2e-5, 1e-5, false},
+		{0, 1e-30, 1e-5, false},
+		{-2, 2, 1, false},
+		{float32(math.NaN()), float32(math.NaN()), 1, false},
+	}
+	for _, tt := range tests {
+		if x := NearEqRel(tt.a, tt.b, tt.tol); x != tt.want {
+			t.Errorf("NearEqRel(%g, %g, %g) = %v, want %v", tt.a, tt.b, tt.tol, x, tt.want)
+		}
+	}
+}
+
+func TestNearEqULP(t *testing.T) {
+	next := func(f float32, n int) float32 {
+		for range n {
+			f = math.Nextafter32(f, float32(math.Inf(1)))
+		}
+		return f
+	}
+	tests := []struct {
+		a, b float32
+		ulps uint
+		want bool
+	}{
+		{1, 1, 0, true},
+		{1, next(1, 1), 0, false},
+		{1, next(1, 1), 1, true},
+		{1, next(1, 4), 4, true},
+		{1, next(1, 5), 4, false},
+		{10000, next(10000, 3), 4, true},
+		{float32(math.Copysign(0, -1)), 0, 0, true},
+		{-math.SmallestNonzeroFloat32, math.SmallestNonzeroFloat32, 2, true},
+		{-math.SmallestNonzeroFloat32, math.SmallestNonzeroFloat32, 1, false},
+		{-1, 1, 1 << 20, false},
+		{math.MaxFloat32, float32(math.Inf(1)), 1, true},
+		{float32(math.NaN()), float32(math.NaN()), math.MaxUint32, false},
+	}
+	for _, tt := range tests {
+		if x := NearEqULP(tt.a, tt.b, tt.ulps); x != tt.want {
+			t.Errorf("NearEqULP(%g, %g, %d) = %v, want %v", tt.a, tt.b, tt.ulps, x, tt.want)
+		}
+	}
+
+	// float64
+	if !NearEqULP(1.0, math.Nextafter(1, 2), 1) {
+		t.Errorf("NearEqULP(1.0, 1.0+ulp, 1) = false, want true")
+	}
+	if NearEqULP(1.0, float64(next(1, 1)), 1000) {
+		t.Errorf("NearEqULP(1.0, float32 ulp above 1, 1000) = true, want false")
+	}
+	if !NearEqULP(-math.MaxFloat64, math.MaxFloat64, math.MaxUint) {
+		t.Errorf("NearEqULP(-MaxFloat64, MaxFloat64, MaxUint) = false, want true")
+	}
+}
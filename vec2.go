@@ -6,12 +6,18 @@ package geom
 
 import "math"
 
-// A Vec2 represents a vector with coordinates X and Y in 2-dimensional
+// A Vector2 represents a vector with coordinates X and Y in 2-dimensional
 // euclidean space.
-type Vec2 struct {
-	X, Y float32
+type Vector2[T Float] struct {
+	X, Y T
 }
 
+// A Vec2 is a 2-dimensional vector with float32 coordinates.
+type Vec2 = Vector2[float32]
+
+// A Vec2d is a 2-dimensional vector with float64 coordinates.
+type Vec2d = Vector2[float64]
+
 // A Size represents the dimensions of a rectangle.
 type Size struct {
 	// Width and height
@@ -34,134 +40,276 @@ func V2(x, y float32) Vec2 {
 	return Vec2{x, y}
 }
 
+// V2d is shorthand for Vec2d{X: x, Y: y}.
+func V2d(x, y float64) Vec2d {
+	return Vec2d{x, y}
+}
+
 // Add returns the vector v+w.
-func (v Vec2) Add(w Vec2) Vec2 {
-	return Vec2{v.X + w.X, v.Y + w.Y}
+func (v Vector2[T]) Add(w Vector2[T]) Vector2[T] {
+	return Vector2[T]{v.X + w.X, v.Y + w.Y}
 }
 
 // Sub returns the vector v-w.
-func (v Vec2) Sub(w Vec2) Vec2 {
-	return Vec2{v.X - w.X, v.Y - w.Y}
+func (v Vector2[T]) Sub(w Vector2[T]) Vector2[T] {
+	return Vector2[T]{v.X - w.X, v.Y - w.Y}
 }
 
 // Mul returns the vector v*s.
-func (v Vec2) Mul(s float32) Vec2 {
-	return Vec2{v.X * s, v.Y * s}
+func (v Vector2[T]) Mul(s T) Vector2[T] {
+	return Vector2[T]{v.X * s, v.Y * s}
 }
 
 // Div returns the vector v/s.
-func (v Vec2) Div(s float32) Vec2 {
-	return Vec2{v.X / s, v.Y / s}
+func (v Vector2[T]) Div(s T) Vector2[T] {
+	return Vector2[T]{v.X / s, v.Y / s}
 }
 
 // Neg returns the negated vector of v.
-func (v Vec2) Neg() Vec2 {
+func (v Vector2[T]) Neg() Vector2[T] {
 	return v.Mul(-1)
 }
 
 // Dot returns the dot (a.k.a. scalar) product of v and w.
-func (v Vec2) Dot(w Vec2) float32 {
+func (v Vector2[T]) Dot(w Vector2[T]) T {
 	return v.X*w.X + v.Y*w.Y
 }
 
 // CrossLen returns the length that the cross product of v and w would have
 // in 3-dimensional euclidean space. This is effectively the Z component
 // of the 3D cross product vector.
-func (v Vec2) CrossLen(w Vec2) float32 {
+func (v Vector2[T]) CrossLen(w Vector2[T]) T {
 	return v.X*w.Y - v.Y*w.X
 }
 
 // CompMul returns the component-wise multiplication of two vectors.
-func (v Vec2) CompMul(w Vec2) Vec2 {
-	return Vec2{v.X * w.X, v.Y * w.Y}
+func (v Vector2[T]) CompMul(w Vector2[T]) Vector2[T] {
+	return Vector2[T]{v.X * w.X, v.Y * w.Y}
 }
 
 // CompDiv returns the component-wise division of two vectors.
-func (v Vec2) CompDiv(w Vec2) Vec2 {
-	return Vec2{v.X / w.X, v.Y / w.Y}
+func (v Vector2[T]) CompDiv(w Vector2[T]) Vector2[T] {
+	return Vector2[T]{v.X / w.X, v.Y / w.Y}
 }
 
 // SqDist returns the square of the euclidean distance between two vectors.
-func (v Vec2) SqDist(w Vec2) float32 {
+func (v Vector2[T]) SqDist(w Vector2[T]) T {
 	return v.Sub(w).SqLen()
 }
 
 // Dist returns the euclidean distance between two vectors.
-func (v Vec2) Dist(w Vec2) float32 {
+func (v Vector2[T]) Dist(w Vector2[T]) T {
 	return v.Sub(w).Len()
 }
 
 // SqLen returns the square of the length (euclidean norm) of a vector.
-func (v Vec2) SqLen() float32 {
+func (v Vector2[T]) SqLen() T {
 	return v.Dot(v)
 }
 
 // Len returns the length (euclidean norm) of a vector.
-func (v Vec2) Len() float32 {
-	return float32(math.Sqrt(float64(v.SqLen())))
+func (v Vector2[T]) Len() T {
+	return T(math.Sqrt(float64(v.SqLen())))
 }
 
 // Norm returns the normalized vector of a vector.
-func (v Vec2) Norm() Vec2 {
+func (v Vector2[T]) Norm() Vector2[T] {
 	return v.Div(v.Len())
 }
 
 // Reflect returns the reflection vector of v given a normal n.
-func (v Vec2) Reflect(n Vec2) Vec2 {
+func (v Vector2[T]) Reflect(n Vector2[T]) Vector2[T] {
 	return v.Sub(n.Mul(2 * v.Dot(n)))
 }
 
+// Refract returns the refraction vector of the incident vector v at a surface
+// with normal n, where eta is the ratio of the refractive indices of the
+// medium v comes from and the medium it enters. Both v and n should be
+// normalized and n should point against v. In case of total internal
+// reflection the zero vector and false are returned.
+func (v Vector2[T]) Refract(n Vector2[T], eta T) (Vector2[T], bool) {
+	d := n.Dot(v)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vector2[T]{}, false
+	}
+	return v.Mul(eta).Sub(n.Mul(eta*d + T(math.Sqrt(float64(k))))), true
+}
+
 // Lerp returns the linear interpolation between v and w by amount t.
 // The amount t is usually a value between 0 and 1. If t=0 v will be
 // returned; if t=1 w will be returned.
-func (v Vec2) Lerp(w Vec2, t float32) Vec2 {
+func (v Vector2[T]) Lerp(w Vector2[T], t T) Vector2[T] {
 	// return v.Add(w.Sub(v).Mul(t))
-	return Vec2{lerp(v.X, w.X, t), lerp(v.Y, w.Y, t)}
+	return Vector2[T]{lerp(v.X, w.X, t), lerp(v.Y, w.Y, t)}
 }
 
-// angle returns the angle (counterclockwise) of vector v with the x axis in
-// radians. The result is in the interval [0,2π).
-func (v Vec2) angle() float32 {
+// Angle returns the angle (counterclockwise) of vector v with the x axis in
+// radians. The result is in the interval [0,2π). The angle of the zero
+// vector is 0.
+func (v Vector2[T]) Angle() T {
 	a := math.Atan2(float64(v.Y), float64(v.X))
 	if a < 0 {
 		a += 2 * math.Pi
 	}
-	return float32(a)
+	return T(a)
+}
+
+// AngleTo returns the unsigned angle between the vectors v and w in radians.
+// The result is in the interval [0,π]. If v or w is the zero vector, the
+// result is 0.
+func (v Vector2[T]) AngleTo(w Vector2[T]) T {
+	return T(math.Atan2(math.Abs(float64(v.CrossLen(w))), float64(v.Dot(w))))
+}
+
+// SignedAngleTo returns the angle in radians by which v has to be rotated
+// counterclockwise to point in the direction of w. The result is in the
+// interval (-π,π]; it is negative if the shorter rotation is clockwise.
+// If v or w is the zero vector, the result is 0.
+func (v Vector2[T]) SignedAngleTo(w Vector2[T]) T {
+	return T(math.Atan2(float64(v.CrossLen(w)), float64(v.Dot(w))))
+}
+
+// Rotate returns vector v rotated counterclockwise by the given angle in
+// radians.
+func (v Vector2[T]) Rotate(angle T) Vector2[T] {
+	s, c := math.Sincos(float64(angle))
+	sin, cos := T(s), T(c)
+	return Vector2[T]{v.X*cos - v.Y*sin, v.X*sin + v.Y*cos}
+}
+
+// RotateTowards returns vector v rotated towards the direction of target by
+// at most maxAngle radians, taking the shorter way. It does not overshoot:
+// if the angle between v and target is less than maxAngle, the result
+// points in the direction of target. The length of v is preserved.
+// If v or target is the zero vector, v is returned.
+func (v Vector2[T]) RotateTowards(target Vector2[T], maxAngle T) Vector2[T] {
+	if v.SqLen() == 0 || target.SqLen() == 0 {
+		return v
+	}
+	θ := v.SignedAngleTo(target)
+	if θ < 0 {
+		θ = max(θ, -maxAngle)
+	} else {
+		θ = min(θ, maxAngle)
+	}
+	return v.Rotate(θ)
+}
+
+// Slerp returns the spherical linear interpolation between v and w by
+// amount t. The direction is rotated with constant angular velocity from
+// the direction of v towards the direction of w, taking the shorter way,
+// while the length is interpolated linearly. If t=0 v will be returned;
+// if t=1 w will be returned. If one of the vectors is the zero vector or
+// they point in almost the same direction, it is equivalent to Lerp.
+func (v Vector2[T]) Slerp(w Vector2[T], t T) Vector2[T] {
+	lv, lw := v.Len(), w.Len()
+	if lv == 0 || lw == 0 {
+		return v.Lerp(w, t)
+	}
+	θ := v.SignedAngleTo(w)
+	if math.Abs(float64(θ)) < epsilon {
+		return v.Lerp(w, t)
+	}
+	return v.Rotate(θ * t).Mul(lerp(lv, lw, t) / lv)
 }
 
 // Min returns a vector with each component set to the lesser value
 // of the corresponding component pair of v and w.
-func (v Vec2) Min(w Vec2) Vec2 {
-	return Vec2{min(v.X, w.X), min(v.Y, w.Y)}
+func (v Vector2[T]) Min(w Vector2[T]) Vector2[T] {
+	return Vector2[T]{min(v.X, w.X), min(v.Y, w.Y)}
 }
 
 // Max returns a vector with each component set to the greater value
 // of the corresponding component pair of v and w.
-func (v Vec2) Max(w Vec2) Vec2 {
-	return Vec2{max(v.X, w.X), max(v.Y, w.Y)}
+func (v Vector2[T]) Max(w Vector2[T]) Vector2[T] {
+	return Vector2[T]{max(v.X, w.X), max(v.Y, w.Y)}
+}
+
+// Clamp returns a vector with each component of v clamped to the interval
+// spanned by the corresponding components of lo and hi.
+func (v Vector2[T]) Clamp(lo, hi Vector2[T]) Vector2[T] {
+	return v.Max(lo).Min(hi)
+}
+
+// ClampLen returns v scaled down to length maxLen if it is longer than
+// maxLen, otherwise v. The direction of v is preserved. maxLen must not be
+// negative.
+func (v Vector2[T]) ClampLen(maxLen T) Vector2[T] {
+	if sq := v.SqLen(); sq > maxLen*maxLen {
+		return v.Mul(maxLen / T(math.Sqrt(float64(sq))))
+	}
+	return v
+}
+
+// MoveTowards returns the point reached by moving from v in the direction
+// of target by the distance maxDist. It does not overshoot: if target is
+// closer than maxDist, target is returned. A negative maxDist moves away
+// from target.
+func (v Vector2[T]) MoveTowards(target Vector2[T], maxDist T) Vector2[T] {
+	d := target.Sub(v)
+	dist := d.Len()
+	if dist <= maxDist || dist == 0 {
+		return target
+	}
+	return v.Add(d.Mul(maxDist / dist))
 }
 
 // Transform transforms vector v with 4x4 matrix m.
-func (v Vec2) Transform(m *Mat4) Vec2 {
-	return Vec2{
+func (v Vector2[T]) Transform(m *Matrix4[T]) Vector2[T] {
+	return Vector2[T]{
 		m[0][0]*v.X + m[1][0]*v.Y + m[3][0],
 		m[0][1]*v.X + m[1][1]*v.Y + m[3][1],
 	}
 }
 
 // Z returns a Vec3 based on v with the additional coordinate z.
-func (v Vec2) Z(z float32) Vec3 {
-	return Vec3{v.X, v.Y, z}
+func (v Vector2[T]) Z(z T) Vector3[T] {
+	return Vector3[T]{v.X, v.Y, z}
 }
 
 // NearEq returns whether v and w are approximately equal. This relation is not
 // transitive in general. The tolerance for the floating-point components is
 // ±1e-5.
-func (v Vec2) NearEq(w Vec2) bool {
+func (v Vector2[T]) NearEq(w Vector2[T]) bool {
 	return nearEq(v.X, w.X, epsilon) && nearEq(v.Y, w.Y, epsilon)
 }
 
+// NearEqAbs returns whether v and w are approximately equal, with each pair
+// of components differing by at most the absolute tolerance tol.
+// See the NearEqAbs function.
+func (v Vector2[T]) NearEqAbs(w Vector2[T], tol T) bool {
+	return NearEqAbs(v.X, w.X, tol) &&
+		NearEqAbs(v.Y, w.Y, tol)
+}
+
+// NearEqRel returns whether v and w are approximately equal, with each pair
+// of components differing by at most the relative tolerance tol.
+// See the NearEqRel function.
+func (v Vector2[T]) NearEqRel(w Vector2[T], tol T) bool {
+	return NearEqRel(v.X, w.X, tol) &&
+		NearEqRel(v.Y, w.Y, tol)
+}
+
+// NearEqULP returns whether v and w are approximately equal, with each pair
+// of components at most ulps units in the last place apart.
+// See the NearEqULP function.
+func (v Vector2[T]) NearEqULP(w Vector2[T], ulps uint) bool {
+	return NearEqULP(v.X, w.X, ulps) &&
+		NearEqULP(v.Y, w.Y, ulps)
+}
+
 // String returns a string representation of v like "(3.25, -1.5)".
-func (v Vec2) String() string {
+func (v Vector2[T]) String() string {
 	return "(" + str(v.X) + ", " + str(v.Y) + ")"
 }
+
+// Float32 returns v with its coordinates converted to float32.
+func (v Vector2[T]) Float32() Vec2 {
+	return Vec2{float32(v.X), float32(v.Y)}
+}
+
+// Float64 returns v with its coordinates converted to float64.
+func (v Vector2[T]) Float64() Vec2d {
+	return Vec2d{float64(v.X), float64(v.Y)}
+}
@@ -328,7 +328,7 @@ func TestVec2Angle(t *testing.T) {
 		{V2(1, -1), math.Pi * 7 / 4},
 	}
 	for _, tt := range tests {
-		if x := tt.v.angle(); x != tt.want {
+		if x := tt.v.Angle(); x != tt.want {
 			t.Errorf("%s.Angle() = %g, want %g", tt.v, x, tt.want)
 		}
 	}
@@ -390,3 +390,180 @@ func TestVec2Z(t *testing.T) {
 		}
 	}
 }
+
+func TestVec2AngleTo(t *testing.T) {
+	tests := []struct {
+		v, w   Vec2
+		angle  float32
+		signed float32
+	}{
+		{V2(1, 0), V2(0, 1), math.Pi / 2, math.Pi / 2},
+		{V2(0, 1), V2(1, 0), math.Pi / 2, -math.Pi / 2},
+		{V2(1, 0), V2(-1, 0), math.Pi, math.Pi},
+		{V2(2, 2), V2(3, 3), 0, 0},
+		{V2(1, 0), V2(1, -1), math.Pi / 4, -math.Pi / 4},
+		{V2(0, 0), V2(1, 1), 0, 0},
+	}
+	for _, tt := range tests {
+		if x := tt.v.AngleTo(tt.w); !nearEq(x, tt.angle, epsilon) {
+			t.Errorf("%s.AngleTo(%s) = %g, want %g", tt.v, tt.w, x, tt.angle)
+		}
+		if x := tt.v.SignedAngleTo(tt.w); !nearEq(x, tt.signed, epsilon) {
+			t.Errorf("%s.SignedAngleTo(%s) = %g, want %g", tt.v, tt.w, x, tt.signed)
+		}
+	}
+}
+
+func TestVec2Clamp(t *testing.T) {
+	lo, hi := V2(-1, 0), V2(1, 2)
+	tests := []struct {
+		v, want Vec2
+	}{
+		{V2(0, 1), V2(0, 1)},
+		{V2(-3, 1), V2(-1, 1)},
+		{V2(5, -2), V2(1, 0)},
+		{V2(0.5, 4), V2(0.5, 2)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Clamp(lo, hi); x != tt.want {
+			t.Errorf("%s.Clamp(%s, %s) = %s, want %s", tt.v, lo, hi, x, tt.want)
+		}
+	}
+}
+
+func TestVec2ClampLen(t *testing.T) {
+	tests := []struct {
+		v      Vec2
+		maxLen float32
+		want   Vec2
+	}{
+		{V2(3, 4), 10, V2(3, 4)},
+		{V2(3, 4), 5, V2(3, 4)},
+		{V2(3, 4), 2.5, V2(1.5, 2)},
+		{V2(3, 4), 0, V2(0, 0)},
+		{V2(0, 0), 1, V2(0, 0)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.ClampLen(tt.maxLen); !x.NearEq(tt.want) {
+			t.Errorf("%s.ClampLen(%g) = %s, want %s", tt.v, tt.maxLen, x, tt.want)
+		}
+	}
+}
+
+func TestVec2MoveTowards(t *testing.T) {
+	tests := []struct {
+		v, target Vec2
+		maxDist   float32
+		want      Vec2
+	}{
+		{V2(0, 0), V2(3, 4), 2.5, V2(1.5, 2)},
+		{V2(0, 0), V2(3, 4), 5, V2(3, 4)},
+		{V2(0, 0), V2(3, 4), 100, V2(3, 4)},
+		{V2(0, 0), V2(3, 4), -5, V2(-3, -4)},
+		{V2(1, 1), V2(1, 1), 2, V2(1, 1)},
+		{V2(1, 1), V2(1, 1), -2, V2(1, 1)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.MoveTowards(tt.target, tt.maxDist); !x.NearEq(tt.want) {
+			t.Errorf("%s.MoveTowards(%s, %g) = %s, want %s", tt.v, tt.target, tt.maxDist, x, tt.want)
+		}
+	}
+}
+
+func TestVec2Refract(t *testing.T) {
+	s := float32(math.Sqrt2 / 2)
+	tests := []struct {
+		v, n   Vec2
+		eta    float32
+		want   Vec2
+		wantOK bool
+	}{
+		// Equal refractive indices: no change of direction
+		{V2(s, -s), V2(0, 1), 1, V2(s, -s), true},
+		// Perpendicular incidence
+		{V2(0, -1), V2(0, 1), 1.5, V2(0, -1), true},
+		// sin(θt) = 1/1.5 * sin(45°)
+		{V2(s, -s), V2(0, 1), 1 / 1.5, V2(0.47140452, -0.8819171), true},
+		// Total internal reflection: sin(θt) = 1.5 * sin(45°) > 1
+		{V2(s, -s), V2(0, 1), 1.5, V2(0, 0), false},
+	}
+	for _, tt := range tests {
+		x, ok := tt.v.Refract(tt.n, tt.eta)
+		if !x.NearEq(tt.want) || ok != tt.wantOK {
+			t.Errorf("%s.Refract(%s, %g) = %s, %v, want %s, %v", tt.v, tt.n, tt.eta, x, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestVec2Rotate(t *testing.T) {
+	tests := []struct {
+		v     Vec2
+		angle float32
+		want  Vec2
+	}{
+		{V2(1, 0), math.Pi / 2, V2(0, 1)},
+		{V2(1, 0), -math.Pi / 2, V2(0, -1)},
+		{V2(2, 1), math.Pi, V2(-2, -1)},
+		{V2(1, 1), math.Pi / 4, V2(0, math.Sqrt2)},
+		{V2(3, 4), 0, V2(3, 4)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Rotate(tt.angle); !x.NearEq(tt.want) {
+			t.Errorf("%s.Rotate(%g) = %s, want %s", tt.v, tt.angle, x, tt.want)
+		}
+	}
+}
+
+func TestVec2RotateTowards(t *testing.T) {
+	tests := []struct {
+		v, target Vec2
+		maxAngle  float32
+		want      Vec2
+	}{
+		{V2(2, 0), V2(0, 1), math.Pi / 4, V2(math.Sqrt2, math.Sqrt2)},
+		{V2(2, 0), V2(0, -1), math.Pi / 4, V2(math.Sqrt2, -math.Sqrt2)},
+		{V2(2, 0), V2(0, 5), math.Pi, V2(0, 2)},
+		{V2(2, 0), V2(0, 0), 1, V2(2, 0)},
+		{V2(0, 0), V2(1, 0), 1, V2(0, 0)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.RotateTowards(tt.target, tt.maxAngle); !x.NearEq(tt.want) {
+			t.Errorf("%s.RotateTowards(%s, %g) = %s, want %s", tt.v, tt.target, tt.maxAngle, x, tt.want)
+		}
+	}
+}
+
+func TestVec2Slerp(t *testing.T) {
+	tests := []struct {
+		v, w Vec2
+		t    float32
+		want Vec2
+	}{
+		{V2(1, 0), V2(0, 1), 0, V2(1, 0)},
+		{V2(1, 0), V2(0, 1), 1, V2(0, 1)},
+		{V2(1, 0), V2(0, 1), 0.5, V2(math.Sqrt2/2, math.Sqrt2/2)},
+		{V2(1, 0), V2(0, -3), 0.5, V2(math.Sqrt2, -math.Sqrt2)},
+		{V2(1, 0), V2(3, 0), 0.5, V2(2, 0)},
+		{V2(0, 0), V2(0, 2), 0.5, V2(0, 1)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Slerp(tt.w, tt.t); !x.NearEq(tt.want) {
+			t.Errorf("%s.Slerp(%s, %g) = %s, want %s", tt.v, tt.w, tt.t, x, tt.want)
+		}
+	}
+}
+
+func TestVec2NearEqTol(t *testing.T) {
+	v := V2(10000, 0.00001)
+	w := V2(10000.001, 0.000011)
+	if !v.NearEqAbs(w, 0.01) || v.NearEqAbs(w, 1e-6) {
+		t.Errorf("%s.NearEqAbs(%s, tol) gives wrong results", v, w)
+	}
+	if !v.NearEqRel(w, 0.1) || v.NearEqRel(w, 0.01) {
+		t.Errorf("%s.NearEqRel(%s, tol) gives wrong results", v, w)
+	}
+	u := V2(math.Nextafter32(10000, 0), 0.00001)
+	if !v.NearEqULP(u, 1) || v.NearEqULP(u, 0) {
+		t.Errorf("%s.NearEqULP(%s, ulps) gives wrong results", v, u)
+	}
+}
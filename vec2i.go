@@ -0,0 +1,172 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"image"
+	"math"
+	"strconv"
+)
+
+// A Vec2i represents a vector with integer coordinates X and Y, e.g. a
+// pixel position or the index of a cell in a 2-dimensional grid.
+type Vec2i struct {
+	X, Y int
+}
+
+// V2i is shorthand for Vec2i{X: x, Y: y}.
+func V2i(x, y int) Vec2i {
+	return Vec2i{x, y}
+}
+
+// Vec2iFromPoint converts the point p of the image package to a Vec2i.
+func Vec2iFromPoint(p image.Point) Vec2i {
+	return Vec2i{p.X, p.Y}
+}
+
+// Add returns the vector v+w.
+func (v Vec2i) Add(w Vec2i) Vec2i {
+	return Vec2i{v.X + w.X, v.Y + w.Y}
+}
+
+// Sub returns the vector v-w.
+func (v Vec2i) Sub(w Vec2i) Vec2i {
+	return Vec2i{v.X - w.X, v.Y - w.Y}
+}
+
+// Mul returns the vector v*s.
+func (v Vec2i) Mul(s int) Vec2i {
+	return Vec2i{v.X * s, v.Y * s}
+}
+
+// Div returns the vector v/s. The components are truncated toward zero
+// like with Go's integer division.
+func (v Vec2i) Div(s int) Vec2i {
+	return Vec2i{v.X / s, v.Y / s}
+}
+
+// Neg returns the negated vector of v.
+func (v Vec2i) Neg() Vec2i {
+	return Vec2i{-v.X, -v.Y}
+}
+
+// Dot returns the dot (a.k.a. scalar) product of v and w.
+func (v Vec2i) Dot(w Vec2i) int {
+	return v.X*w.X + v.Y*w.Y
+}
+
+// CompMul returns the component-wise multiplication of two vectors.
+func (v Vec2i) CompMul(w Vec2i) Vec2i {
+	return Vec2i{v.X * w.X, v.Y * w.Y}
+}
+
+// Abs returns a vector with the absolute values of the components of v.
+func (v Vec2i) Abs() Vec2i {
+	return Vec2i{abs(v.X), abs(v.Y)}
+}
+
+// Min returns a vector with each component set to the lesser value
+// of the corresponding component pair of v and w.
+func (v Vec2i) Min(w Vec2i) Vec2i {
+	return Vec2i{min(v.X, w.X), min(v.Y, w.Y)}
+}
+
+// Max returns a vector with each component set to the greater value
+// of the corresponding component pair of v and w.
+func (v Vec2i) Max(w Vec2i) Vec2i {
+	return Vec2i{max(v.X, w.X), max(v.Y, w.Y)}
+}
+
+// ManhattanDist returns the Manhattan (taxicab) distance between two
+// vectors, i.e. the sum of the absolute differences of their components.
+// It is the number of steps between two grid cells if only the 4 direct
+// neighbors can be reached in one step.
+func (v Vec2i) ManhattanDist(w Vec2i) int {
+	d := v.Sub(w).Abs()
+	return d.X + d.Y
+}
+
+// ChebyshevDist returns the Chebyshev (chessboard) distance between two
+// vectors, i.e. the greatest absolute difference of their components.
+// It is the number of steps between two grid cells if all 8 neighbors
+// including the diagonal ones can be reached in one step.
+func (v Vec2i) ChebyshevDist(w Vec2i) int {
+	d := v.Sub(w).Abs()
+	return max(d.X, d.Y)
+}
+
+// Neighbors4 returns the 4 direct neighbors of v that share an edge with
+// v, in counterclockwise order starting with v+(1,0).
+func (v Vec2i) Neighbors4() [4]Vec2i {
+	return [...]Vec2i{
+		{v.X + 1, v.Y},
+		{v.X, v.Y + 1},
+		{v.X - 1, v.Y},
+		{v.X, v.Y - 1},
+	}
+}
+
+// Neighbors8 returns the 8 neighbors of v that share an edge or a corner
+// with v, in row-major order from v+(-1,-1) to v+(1,1).
+func (v Vec2i) Neighbors8() [8]Vec2i {
+	var n [8]Vec2i
+	i := 0
+	for dy := -1; dy <= 1; dy++ {
+		for dx := -1; dx <= 1; dx++ {
+			if dx == 0 && dy == 0 {
+				continue
+			}
+			n[i] = Vec2i{v.X + dx, v.Y + dy}
+			i++
+		}
+	}
+	return n
+}
+
+// Z returns a 3D vector with the coordinates of v and the given z.
+func (v Vec2i) Z(z int) Vec3i {
+	return Vec3i{v.X, v.Y, z}
+}
+
+// Vec2 returns v converted to a Vec2 with float32 coordinates.
+func (v Vec2i) Vec2() Vec2 {
+	return Vec2{float32(v.X), float32(v.Y)}
+}
+
+// Point returns v converted to a point of the image package.
+func (v Vec2i) Point() image.Point {
+	return image.Point{v.X, v.Y}
+}
+
+// String returns a string representation of v like "(3, -4)".
+func (v Vec2i) String() string {
+	return "(" + strconv.Itoa(v.X) + ", " + strconv.Itoa(v.Y) + ")"
+}
+
+// Floor returns v converted to a Vec2i by rounding the coordinates
+// toward negative infinity.
+func (v Vector2[T]) Floor() Vec2i {
+	return Vec2i{int(math.Floor(float64(v.X))), int(math.Floor(float64(v.Y)))}
+}
+
+// Round returns v converted to a Vec2i by rounding the coordinates to the
+// nearest integer, rounding half away from zero.
+func (v Vector2[T]) Round() Vec2i {
+	return Vec2i{int(math.Round(float64(v.X))), int(math.Round(float64(v.Y)))}
+}
+
+// Ceil returns v converted to a Vec2i by rounding the coordinates
+// toward positive infinity.
+func (v Vector2[T]) Ceil() Vec2i {
+	return Vec2i{int(math.Ceil(float64(v.X))), int(math.Ceil(float64(v.Y)))}
+}
+
+// abs returns the absolute value of x.
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
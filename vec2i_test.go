@@ -0,0 +1,115 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"image"
+	"testing"
+)
+
+func TestVec2iArithmetic(t *testing.T) {
+	v, w := V2i(3, -4), V2i(-1, 2)
+	tests := []struct {
+		name string
+		x    Vec2i
+		want Vec2i
+	}{
+		{"Add", v.Add(w), V2i(2, -2)},
+		{"Sub", v.Sub(w), V2i(4, -6)},
+		{"Mul", v.Mul(3), V2i(9, -12)},
+		{"Div", v.Div(2), V2i(1, -2)},
+		{"Neg", v.Neg(), V2i(-3, 4)},
+		{"CompMul", v.CompMul(w), V2i(-3, -8)},
+		{"Abs", v.Abs(), V2i(3, 4)},
+		{"Min", v.Min(w), V2i(-1, -4)},
+		{"Max", v.Max(w), V2i(3, 2)},
+	}
+	for _, tt := range tests {
+		if tt.x != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, tt.x, tt.want)
+		}
+	}
+	if x := v.Dot(w); x != -11 {
+		t.Errorf("%s.Dot(%s) = %d, want -11", v, w, x)
+	}
+}
+
+func TestVec2iDist(t *testing.T) {
+	tests := []struct {
+		v, w      Vec2i
+		manhattan int
+		chebyshev int
+	}{
+		{V2i(0, 0), V2i(0, 0), 0, 0},
+		{V2i(1, 2), V2i(4, 6), 7, 4},
+		{V2i(-3, 5), V2i(2, 4), 6, 5},
+	}
+	for _, tt := range tests {
+		if x := tt.v.ManhattanDist(tt.w); x != tt.manhattan {
+			t.Errorf("%s.ManhattanDist(%s) = %d, want %d", tt.v, tt.w, x, tt.manhattan)
+		}
+		if x := tt.v.ChebyshevDist(tt.w); x != tt.chebyshev {
+			t.Errorf("%s.ChebyshevDist(%s) = %d, want %d", tt.v, tt.w, x, tt.chebyshev)
+		}
+	}
+}
+
+func TestVec2iNeighbors(t *testing.T) {
+	v := V2i(5, -2)
+	want4 := [...]Vec2i{{6, -2}, {5, -1}, {4, -2}, {5, -3}}
+	if x := v.Neighbors4(); x != want4 {
+		t.Errorf("%s.Neighbors4() = %v, want %v", v, x, want4)
+	}
+	want8 := [...]Vec2i{{4, -3}, {5, -3}, {6, -3}, {4, -2}, {6, -2}, {4, -1}, {5, -1}, {6, -1}}
+	if x := v.Neighbors8(); x != want8 {
+		t.Errorf("%s.Neighbors8() = %v, want %v", v, x, want8)
+	}
+	for _, n := range v.Neighbors8() {
+		if d := v.ChebyshevDist(n); d != 1 {
+			t.Errorf("neighbor %s of %s has Chebyshev distance %d, want 1", n, v, d)
+		}
+	}
+}
+
+func TestVec2Floor(t *testing.T) {
+	tests := []struct {
+		v                  Vec2
+		floor, round, ceil Vec2i
+	}{
+		{V2(1.2, -1.2), V2i(1, -2), V2i(1, -1), V2i(2, -1)},
+		{V2(2.5, -2.5), V2i(2, -3), V2i(3, -3), V2i(3, -2)},
+		{V2(3, -4), V2i(3, -4), V2i(3, -4), V2i(3, -4)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Floor(); x != tt.floor {
+			t.Errorf("%s.Floor() = %s, want %s", tt.v, x, tt.floor)
+		}
+		if x := tt.v.Round(); x != tt.round {
+			t.Errorf("%s.Round() = %s, want %s", tt.v, x, tt.round)
+		}
+		if x := tt.v.Ceil(); x != tt.ceil {
+			t.Errorf("%s.Ceil() = %s, want %s", tt.v, x, tt.ceil)
+		}
+	}
+}
+
+func TestVec2iConversions(t *testing.T) {
+	v := V2i(7, -3)
+	if x := v.Vec2(); x != V2(7, -3) {
+		t.Errorf("%s.Vec2() = %s, want (7, -3)", v, x)
+	}
+	if x := v.Point(); x != image.Pt(7, -3) {
+		t.Errorf("%s.Point() = %v, want (7,-3)", v, x)
+	}
+	if x := Vec2iFromPoint(image.Pt(7, -3)); x != v {
+		t.Errorf("Vec2iFromPoint(image.Pt(7, -3)) = %s, want %s", x, v)
+	}
+	if x := v.Z(2); x != V3i(7, -3, 2) {
+		t.Errorf("%s.Z(2) = %s, want (7, -3, 2)", v, x)
+	}
+	if s := v.String(); s != "(7, -3)" {
+		t.Errorf("%#v.String() = %q, want %q", v, s, "(7, -3)")
+	}
+}
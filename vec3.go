@@ -6,12 +6,18 @@ package geom
 
 import "math"
 
-// A Vec3 represents a vector with coordinates X, Y and Z in 3-dimensional
+// A Vector3 represents a vector with coordinates X, Y and Z in 3-dimensional
 // euclidean space.
-type Vec3 struct {
-	X, Y, Z float32
+type Vector3[T Float] struct {
+	X, Y, Z T
 }
 
+// A Vec3 is a 3-dimensional vector with float32 coordinates.
+type Vec3 = Vector3[float32]
+
+// A Vec3d is a 3-dimensional vector with float64 coordinates.
+type Vec3d = Vector3[float64]
+
 var (
 	// V3Zero is the zero vector (0,0,0).
 	V3Zero = Vec3{0, 0, 0}
@@ -30,39 +36,44 @@ func V3(x, y, z float32) Vec3 {
 	return Vec3{x, y, z}
 }
 
+// V3d is shorthand for Vec3d{X: x, Y: y, Z: z}.
+func V3d(x, y, z float64) Vec3d {
+	return Vec3d{x, y, z}
+}
+
 // Add returns the vector v+w.
-func (v Vec3) Add(w Vec3) Vec3 {
-	return Vec3{v.X + w.X, v.Y + w.Y, v.Z + w.Z}
+func (v Vector3[T]) Add(w Vector3[T]) Vector3[T] {
+	return Vector3[T]{v.X + w.X, v.Y + w.Y, v.Z + w.Z}
 }
 
 // Sub returns the vector v-w.
-func (v Vec3) Sub(w Vec3) Vec3 {
-	return Vec3{v.X - w.X, v.Y - w.Y, v.Z - w.Z}
+func (v Vector3[T]) Sub(w Vector3[T]) Vector3[T] {
+	return Vector3[T]{v.X - w.X, v.Y - w.Y, v.Z - w.Z}
 }
 
 // Mul returns the vector v*s.
-func (v Vec3) Mul(s float32) Vec3 {
-	return Vec3{v.X * s, v.Y * s, v.Z * s}
+func (v Vector3[T]) Mul(s T) Vector3[T] {
+	return Vector3[T]{v.X * s, v.Y * s, v.Z * s}
 }
 
 // Div returns the vector v/s.
-func (v Vec3) Div(s float32) Vec3 {
-	return Vec3{v.X / s, v.Y / s, v.Z / s}
+func (v Vector3[T]) Div(s T) Vector3[T] {
+	return Vector3[T]{v.X / s, v.Y / s, v.Z / s}
 }
 
 // Neg returns the negated vector of v.
-func (v Vec3) Neg() Vec3 {
+func (v Vector3[T]) Neg() Vector3[T] {
 	return v.Mul(-1)
 }
 
 // Dot returns the dot (a.k.a. scalar) product of v and w.
-func (v Vec3) Dot(w Vec3) float32 {
+func (v Vector3[T]) Dot(w Vector3[T]) T {
 	return v.X*w.X + v.Y*w.Y + v.Z*w.Z
 }
 
 // Cross returns the cross product of v and w.
-func (v Vec3) Cross(w Vec3) Vec3 {
-	return Vec3{
+func (v Vector3[T]) Cross(w Vector3[T]) Vector3[T] {
+	return Vector3[T]{
 		v.Y*w.Z - v.Z*w.Y,
 		v.Z*w.X - v.X*w.Z,
 		v.X*w.Y - v.Y*w.X,
@@ -70,56 +81,171 @@ func (v Vec3) Cross(w Vec3) Vec3 {
 }
 
 // CompMul returns the component-wise multiplication of two vectors.
-func (v Vec3) CompMul(w Vec3) Vec3 {
-	return Vec3{v.X * w.X, v.Y * w.Y, v.Z * w.Z}
+func (v Vector3[T]) CompMul(w Vector3[T]) Vector3[T] {
+	return Vector3[T]{v.X * w.X, v.Y * w.Y, v.Z * w.Z}
 }
 
 // CompDiv returns the component-wise division of two vectors.
-func (v Vec3) CompDiv(w Vec3) Vec3 {
-	return Vec3{v.X / w.X, v.Y / w.Y, v.Z / w.Z}
+func (v Vector3[T]) CompDiv(w Vector3[T]) Vector3[T] {
+	return Vector3[T]{v.X / w.X, v.Y / w.Y, v.Z / w.Z}
 }
 
 // SqDist returns the square of the euclidean distance between two vectors.
-func (v Vec3) SqDist(w Vec3) float32 {
+func (v Vector3[T]) SqDist(w Vector3[T]) T {
 	return v.Sub(w).SqLen()
 }
 
 // Dist returns the euclidean distance between two vectors.
-func (v Vec3) Dist(w Vec3) float32 {
+func (v Vector3[T]) Dist(w Vector3[T]) T {
 	return v.Sub(w).Len()
 }
 
 // SqLen returns the square of the length (euclidean norm) of a vector.
-func (v Vec3) SqLen() float32 {
+func (v Vector3[T]) SqLen() T {
 	return v.Dot(v)
 }
 
 // Len returns the length (euclidean norm) of a vector.
-func (v Vec3) Len() float32 {
-	return float32(math.Sqrt(float64(v.SqLen())))
+func (v Vector3[T]) Len() T {
+	return T(math.Sqrt(float64(v.SqLen())))
 }
 
 // Norm returns the normalized vector of a vector.
-func (v Vec3) Norm() Vec3 {
+func (v Vector3[T]) Norm() Vector3[T] {
 	return v.Div(v.Len())
 }
 
 // Reflect returns the reflection vector of v given a normal n.
-func (v Vec3) Reflect(n Vec3) Vec3 {
+func (v Vector3[T]) Reflect(n Vector3[T]) Vector3[T] {
 	return v.Sub(n.Mul(2 * v.Dot(n)))
 }
 
+// Refract returns the refraction vector of the incident vector v at a surface
+// with normal n, where eta is the ratio of the refractive indices of the
+// medium v comes from and the medium it enters. Both v and n should be
+// normalized and n should point against v. In case of total internal
+// reflection the zero vector and false are returned.
+func (v Vector3[T]) Refract(n Vector3[T], eta T) (Vector3[T], bool) {
+	d := n.Dot(v)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		return Vector3[T]{}, false
+	}
+	return v.Mul(eta).Sub(n.Mul(eta*d + T(math.Sqrt(float64(k))))), true
+}
+
 // Lerp returns the linear interpolation between v and w by amount t.
 // The amount t is usually a value between 0 and 1. If t=0 v will be
 // returned; if t=1 w will be returned.
-func (v Vec3) Lerp(w Vec3, t float32) Vec3 {
-	return Vec3{lerp(v.X, w.X, t), lerp(v.Y, w.Y, t), lerp(v.Z, w.Z, t)}
+func (v Vector3[T]) Lerp(w Vector3[T], t T) Vector3[T] {
+	return Vector3[T]{lerp(v.X, w.X, t), lerp(v.Y, w.Y, t), lerp(v.Z, w.Z, t)}
+}
+
+// AngleTo returns the unsigned angle between the vectors v and w in radians.
+// The result is in the interval [0,π]. If v or w is the zero vector, the
+// result is 0.
+func (v Vector3[T]) AngleTo(w Vector3[T]) T {
+	return T(math.Atan2(float64(v.Cross(w).Len()), float64(v.Dot(w))))
+}
+
+// SignedAngleTo returns the angle in radians by which v has to be rotated
+// counterclockwise about axis to point in the direction of w, when looking
+// from the tip of axis towards the origin. Only the components of v and w
+// perpendicular to axis are taken into account. The result is in the
+// interval (-π,π].
+func (v Vector3[T]) SignedAngleTo(w, axis Vector3[T]) T {
+	a := axis.Norm()
+	// Project both vectors onto the plane perpendicular to the axis.
+	vp := v.Sub(a.Mul(a.Dot(v)))
+	wp := w.Sub(a.Mul(a.Dot(w)))
+	return T(math.Atan2(float64(a.Dot(vp.Cross(wp))), float64(vp.Dot(wp))))
+}
+
+// Rotate returns vector v rotated by the given angle in radians around the
+// given axis, using Rodrigues' rotation formula. The rotation is
+// counterclockwise when looking from the tip of axis towards the origin,
+// like with Mat4.Rot.
+func (v Vector3[T]) Rotate(angle T, axis Vector3[T]) Vector3[T] {
+	k := axis.Norm()
+	s, c := math.Sincos(float64(angle))
+	sin, cos := T(s), T(c)
+	// v' = v cosθ + (k×v) sinθ + k (k·v)(1-cosθ)
+	return v.Mul(cos).Add(k.Cross(v).Mul(sin)).Add(k.Mul(k.Dot(v) * (1 - cos)))
+}
+
+// RotateTowards returns vector v rotated towards the direction of target by
+// at most maxAngle radians, around the axis perpendicular to both. It does
+// not overshoot: if the angle between v and target is less than maxAngle,
+// the result points in the direction of target. The length of v is
+// preserved. If v or target is the zero vector, v is returned.
+func (v Vector3[T]) RotateTowards(target Vector3[T], maxAngle T) Vector3[T] {
+	if v.SqLen() == 0 || target.SqLen() == 0 {
+		return v
+	}
+	θ := v.AngleTo(target)
+	if θ <= maxAngle {
+		return target.Mul(v.Len() / target.Len())
+	}
+	return v.Rotate(maxAngle, v.rotationAxis(target))
+}
+
+// Slerp returns the spherical linear interpolation between v and w by
+// amount t. The direction is rotated with constant angular velocity from
+// the direction of v towards the direction of w, while the length is
+// interpolated linearly. If t=0 v will be returned; if t=1 w will be
+// returned. If one of the vectors is the zero vector or they point in
+// almost the same direction, it is equivalent to Lerp. If they point in
+// opposite directions, the rotation axis is an arbitrary vector
+// perpendicular to v.
+func (v Vector3[T]) Slerp(w Vector3[T], t T) Vector3[T] {
+	lv, lw := v.Len(), w.Len()
+	if lv == 0 || lw == 0 {
+		return v.Lerp(w, t)
+	}
+	θ := v.AngleTo(w)
+	if θ < epsilon {
+		return v.Lerp(w, t)
+	}
+	return v.Rotate(θ*t, v.rotationAxis(w)).Mul(lerp(lv, lw, t) / lv)
+}
+
+// rotationAxis returns the axis for rotating the non-zero vector v towards
+// w. If v and w are parallel, an arbitrary vector perpendicular to v is
+// returned.
+func (v Vector3[T]) rotationAxis(w Vector3[T]) Vector3[T] {
+	axis := v.Cross(w)
+	if axis.SqLen() < epsilon*epsilon*v.SqLen()*w.SqLen() {
+		return v.Perpendicular()
+	}
+	return axis
+}
+
+// Perpendicular returns a unit vector perpendicular to v. It is the first
+// vector of OrthonormalBasis. v must not be the zero vector.
+func (v Vector3[T]) Perpendicular() Vector3[T] {
+	t, _ := v.OrthonormalBasis()
+	return t
+}
+
+// OrthonormalBasis returns two unit vectors t and b, so that t, b and the
+// normalized vector v form a right-handed orthonormal basis, i.e.
+// t×b = v.Norm(). It uses the branchless method from "Building an
+// Orthonormal Basis, Revisited" (Duff et al., 2017). v must not be the zero
+// vector.
+func (v Vector3[T]) OrthonormalBasis() (t, b Vector3[T]) {
+	n := v.Norm()
+	sign := T(math.Copysign(1, float64(n.Z)))
+	a := -1 / (sign + n.Z)
+	c := n.X * n.Y * a
+	t = Vector3[T]{1 + sign*n.X*n.X*a, sign * c, -sign * n.X}
+	b = Vector3[T]{c, sign + n.Y*n.Y*a, -n.Y}
+	return t, b
 }
 
 // Min returns a vector with each component set to the lesser value
 // of the corresponding component pair of v and w.
-func (v Vec3) Min(w Vec3) Vec3 {
-	return Vec3{
+func (v Vector3[T]) Min(w Vector3[T]) Vector3[T] {
+	return Vector3[T]{
 		min(v.X, w.X),
 		min(v.Y, w.Y),
 		min(v.Z, w.Z),
@@ -128,33 +254,135 @@ func (v Vec3) Min(w Vec3) Vec3 {
 
 // Max returns a vector with each component set to the greater value
 // of the corresponding component pair of v and w.
-func (v Vec3) Max(w Vec3) Vec3 {
-	return Vec3{
+func (v Vector3[T]) Max(w Vector3[T]) Vector3[T] {
+	return Vector3[T]{
 		max(v.X, w.X),
 		max(v.Y, w.Y),
 		max(v.Z, w.Z),
 	}
 }
 
-// Transform transforms vector v with 4x4 matrix m.
-func (v Vec3) Transform(m *Mat4) Vec3 {
-	return Vec3{
+// Clamp returns a vector with each component of v clamped to the interval
+// spanned by the corresponding components of lo and hi.
+func (v Vector3[T]) Clamp(lo, hi Vector3[T]) Vector3[T] {
+	return v.Max(lo).Min(hi)
+}
+
+// ClampLen returns v scaled down to length maxLen if it is longer than
+// maxLen, otherwise v. The direction of v is preserved. maxLen must not be
+// negative.
+func (v Vector3[T]) ClampLen(maxLen T) Vector3[T] {
+	if sq := v.SqLen(); sq > maxLen*maxLen {
+		return v.Mul(maxLen / T(math.Sqrt(float64(sq))))
+	}
+	return v
+}
+
+// MoveTowards returns the point reached by moving from v in the direction
+// of target by the distance maxDist. It does not overshoot: if target is
+// closer than maxDist, target is returned. A negative maxDist moves away
+// from target.
+func (v Vector3[T]) MoveTowards(target Vector3[T], maxDist T) Vector3[T] {
+	d := target.Sub(v)
+	dist := d.Len()
+	if dist <= maxDist || dist == 0 {
+		return target
+	}
+	return v.Add(d.Mul(maxDist / dist))
+}
+
+// Transform transforms vector v with 4x4 matrix m. The vector is treated as
+// a point with an implicit W coordinate of 1, and the resulting W coordinate
+// is dropped without perspective division. This is only correct for affine
+// transformations; use TransformPoint for projective transformations.
+func (v Vector3[T]) Transform(m *Matrix4[T]) Vector3[T] {
+	return Vector3[T]{
 		m[0][0]*v.X + m[1][0]*v.Y + m[2][0]*v.Z + m[3][0],
 		m[0][1]*v.X + m[1][1]*v.Y + m[2][1]*v.Z + m[3][1],
 		m[0][2]*v.X + m[1][2]*v.Y + m[2][2]*v.Z + m[3][2],
 	}
 }
 
+// TransformPoint transforms point v with 4x4 matrix m, including the
+// perspective division by the resulting W coordinate. Use it to transform
+// points with projection matrices like Perspective or Frustum.
+func (v Vector3[T]) TransformPoint(m *Matrix4[T]) Vector3[T] {
+	return v.W(1).Transform(m).PerspDiv()
+}
+
+// TransformDir transforms direction vector v with 4x4 matrix m. The vector
+// is treated as having a W coordinate of 0, so the translation part of m
+// does not affect it.
+func (v Vector3[T]) TransformDir(m *Matrix4[T]) Vector3[T] {
+	return Vector3[T]{
+		m[0][0]*v.X + m[1][0]*v.Y + m[2][0]*v.Z,
+		m[0][1]*v.X + m[1][1]*v.Y + m[2][1]*v.Z,
+		m[0][2]*v.X + m[1][2]*v.Y + m[2][2]*v.Z,
+	}
+}
+
+// TransformNormal transforms surface normal v with the inverse transpose of
+// the upper-left 3x3 part of matrix m, so that it stays perpendicular to the
+// surface under non-uniform scaling. The result is not normalized.
+// For many normals, compute the normal matrix once with Mat3.NormalMatrix
+// and use Mat3.MulVec3 instead.
+func (v Vector3[T]) TransformNormal(m *Matrix4[T]) Vector3[T] {
+	var n Matrix3[T]
+	return n.NormalMatrix(m).MulVec3(v)
+}
+
+// W returns a Vec4 based on v with the additional coordinate w.
+func (v Vector3[T]) W(w T) Vector4[T] {
+	return Vector4[T]{v.X, v.Y, v.Z, w}
+}
+
 // NearEq returns whether v and w are approximately equal. This relation is not
 // transitive in general. The tolerance for the floating-point components is
 // ±1e-5.
-func (v Vec3) NearEq(w Vec3) bool {
+func (v Vector3[T]) NearEq(w Vector3[T]) bool {
 	return nearEq(v.X, w.X, epsilon) &&
 		nearEq(v.Y, w.Y, epsilon) &&
 		nearEq(v.Z, w.Z, epsilon)
 }
 
+// NearEqAbs returns whether v and w are approximately equal, with each pair
+// of components differing by at most the absolute tolerance tol.
+// See the NearEqAbs function.
+func (v Vector3[T]) NearEqAbs(w Vector3[T], tol T) bool {
+	return NearEqAbs(v.X, w.X, tol) &&
+		NearEqAbs(v.Y, w.Y, tol) &&
+		NearEqAbs(v.Z, w.Z, tol)
+}
+
+// NearEqRel returns whether v and w are approximately equal, with each pair
+// of components differing by at most the relative tolerance tol.
+// See the NearEqRel function.
+func (v Vector3[T]) NearEqRel(w Vector3[T], tol T) bool {
+	return NearEqRel(v.X, w.X, tol) &&
+		NearEqRel(v.Y, w.Y, tol) &&
+		NearEqRel(v.Z, w.Z, tol)
+}
+
+// NearEqULP returns whether v and w are approximately equal, with each pair
+// of components at most ulps units in the last place apart.
+// See the NearEqULP function.
+func (v Vector3[T]) NearEqULP(w Vector3[T], ulps uint) bool {
+	return NearEqULP(v.X, w.X, ulps) &&
+		NearEqULP(v.Y, w.Y, ulps) &&
+		NearEqULP(v.Z, w.Z, ulps)
+}
+
 // String returns a string representation of v like "(3.25, -1.5, 1.2)".
-func (v Vec3) String() string {
+func (v Vector3[T]) String() string {
 	return "(" + str(v.X) + ", " + str(v.Y) + ", " + str(v.Z) + ")"
 }
+
+// Float32 returns v with its coordinates converted to float32.
+func (v Vector3[T]) Float32() Vec3 {
+	return Vec3{float32(v.X), float32(v.Y), float32(v.Z)}
+}
+
+// Float64 returns v with its coordinates converted to float64.
+func (v Vector3[T]) Float64() Vec3d {
+	return Vec3d{float64(v.X), float64(v.Y), float64(v.Z)}
+}
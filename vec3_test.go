@@ -362,3 +362,352 @@ func TestVec3Transform(t *testing.T) {
 		}
 	}
 }
+
+func TestVec3TransformPoint(t *testing.T) {
+	var trans, persp, frustum Mat4
+	trans.ID().Translate(&trans, V3(2.5, 3, -1))
+	persp.Perspective(math.Pi/2, 1, 1, 3)
+	frustum.Frustum(-1, 1, -1, 1, 1, 10)
+
+	tests := []struct {
+		v    Vec3
+		m    *Mat4
+		want Vec3
+	}{
+		{V3(1, 2, 3), &trans, V3(3.5, 5, 2)},
+		// Points on the near and far planes map to depth -1 and 1.
+		{V3(0, 0, -1), &persp, V3(0, 0, -1)},
+		{V3(0, 0, -3), &persp, V3(0, 0, 1)},
+		{V3(1, 2, -2), &persp, V3(0.5, 1, 0.5)},
+		{V3(-1, 1, -1), &frustum, V3(-1, 1, -1)},
+		{V3(10, -10, -10), &frustum, V3(1, -1, 1)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.TransformPoint(tt.m); !x.NearEq(tt.want) {
+			t.Errorf("%s.TransformPoint(%v) = %s, want %s", tt.v, *tt.m, x, tt.want)
+		}
+	}
+}
+
+func TestVec3TransformDir(t *testing.T) {
+	var rot, trans, scale Mat4
+	rot.ID().Rot(&rot, math.Pi/2, V3UnitZ)
+	trans.ID().Translate(&trans, V3(2.5, 3, -1))
+	scale.ID().Scale(&scale, V3(2, 3, -4))
+
+	tests := []struct {
+		v    Vec3
+		m    *Mat4
+		want Vec3
+	}{
+		{V3(1, 0, 2), &rot, V3(0, 1, 2)},
+		{V3(1, 2, 3), &trans, V3(1, 2, 3)},
+		{V3(1.5, -3, -1), &scale, V3(3, -9, 4)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.TransformDir(tt.m); !x.NearEq(tt.want) {
+			t.Errorf("%s.TransformDir(%v) = %s, want %s", tt.v, *tt.m, x, tt.want)
+		}
+	}
+}
+
+func TestVec3TransformNormal(t *testing.T) {
+	var rot, trans, scale Mat4
+	rot.ID().Rot(&rot, math.Pi/2, V3UnitZ)
+	trans.ID().Translate(&trans, V3(2.5, 3, -1))
+	scale.ID().Scale(&scale, V3(2, 4, 1))
+
+	tests := []struct {
+		v    Vec3
+		m    *Mat4
+		want Vec3
+	}{
+		{V3(1, 0, 0), &rot, V3(0, 1, 0)},
+		{V3(0, 0, 1), &trans, V3(0, 0, 1)},
+		{V3(1, 1, 0), &scale, V3(0.5, 0.25, 0)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.TransformNormal(tt.m); !x.NearEq(tt.want) {
+			t.Errorf("%s.TransformNormal(%v) = %s, want %s", tt.v, *tt.m, x, tt.want)
+		}
+	}
+}
+
+func TestVec3W(t *testing.T) {
+	tests := []struct {
+		v    Vec3
+		w    float32
+		want Vec4
+	}{
+		{V3(0, -2.3, 3.1), 1, V4(0, -2.3, 3.1, 1)},
+		{V3(-2.5, 3, -1.4), 0, V4(-2.5, 3, -1.4, 0)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.W(tt.w); x != tt.want {
+			t.Errorf("%s.W(%g) = %s, want %s", tt.v, tt.w, x, tt.want)
+		}
+	}
+}
+
+func TestVec3Float64(t *testing.T) {
+	v := V3(0.5, -2, 1e10)
+	d := v.Float64()
+	if d != V3d(0.5, -2, 1e10) {
+		t.Errorf("%s.Float64() = %s, want (0.5, -2, 1e+10)", v, d)
+	}
+	if x := d.Float32(); x != v {
+		t.Errorf("%s.Float32() = %s, want %s", d, x, v)
+	}
+}
+
+func TestVec3dPrecision(t *testing.T) {
+	// 1+2^-30 is not representable as float32.
+	v := V3d(1, 0, 0).Add(V3d(1.0/(1<<30), 0, 0))
+	if v.X == 1 {
+		t.Errorf("Vec3d lost float64 precision: got %s", v)
+	}
+	if s := V3d(0.1, 0.2, 0.3).String(); s != "(0.1, 0.2, 0.3)" {
+		t.Errorf("V3d(0.1, 0.2, 0.3).String() = %q, want %q", s, "(0.1, 0.2, 0.3)")
+	}
+}
+
+func TestVec3AngleTo(t *testing.T) {
+	tests := []struct {
+		v, w  Vec3
+		angle float32
+	}{
+		{V3(1, 0, 0), V3(0, 1, 0), math.Pi / 2},
+		{V3(1, 0, 0), V3(0, 0, -1), math.Pi / 2},
+		{V3(1, 2, 3), V3(-2, -4, -6), math.Pi},
+		{V3(1, 2, 3), V3(2, 4, 6), 0},
+		{V3(1, 0, 0), V3(1, 1, 0), math.Pi / 4},
+		{V3(0, 0, 0), V3(1, 1, 1), 0},
+	}
+	for _, tt := range tests {
+		if x := tt.v.AngleTo(tt.w); !nearEq(x, tt.angle, epsilon) {
+			t.Errorf("%s.AngleTo(%s) = %g, want %g", tt.v, tt.w, x, tt.angle)
+		}
+	}
+}
+
+func TestVec3SignedAngleTo(t *testing.T) {
+	tests := []struct {
+		v, w, axis Vec3
+		want       float32
+	}{
+		{V3(1, 0, 0), V3(0, 1, 0), V3(0, 0, 1), math.Pi / 2},
+		{V3(1, 0, 0), V3(0, 1, 0), V3(0, 0, -1), -math.Pi / 2},
+		{V3(0, 1, 0), V3(0, 0, 1), V3(2, 0, 0), math.Pi / 2},
+		// Components along the axis are ignored.
+		{V3(1, 0, 5), V3(0, -1, -3), V3(0, 0, 1), -math.Pi / 2},
+		{V3(1, 0, 0), V3(-1, 0, 0), V3(0, 0, 1), math.Pi},
+	}
+	for _, tt := range tests {
+		if x := tt.v.SignedAngleTo(tt.w, tt.axis); !nearEq(x, tt.want, epsilon) {
+			t.Errorf("%s.SignedAngleTo(%s, %s) = %g, want %g", tt.v, tt.w, tt.axis, x, tt.want)
+		}
+	}
+}
+
+func TestVec3Clamp(t *testing.T) {
+	lo, hi := V3(-1, 0, 2), V3(1, 2, 3)
+	tests := []struct {
+		v, want Vec3
+	}{
+		{V3(0, 1, 2.5), V3(0, 1, 2.5)},
+		{V3(-3, 1, 0), V3(-1, 1, 2)},
+		{V3(5, -2, 4), V3(1, 0, 3)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Clamp(lo, hi); x != tt.want {
+			t.Errorf("%s.Clamp(%s, %s) = %s, want %s", tt.v, lo, hi, x, tt.want)
+		}
+	}
+}
+
+func TestVec3ClampLen(t *testing.T) {
+	tests := []struct {
+		v      Vec3
+		maxLen float32
+		want   Vec3
+	}{
+		{V3(2, 3, 6), 10, V3(2, 3, 6)},
+		{V3(2, 3, 6), 7, V3(2, 3, 6)},
+		{V3(2, 3, 6), 3.5, V3(1, 1.5, 3)},
+		{V3(0, 0, 0), 1, V3(0, 0, 0)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.ClampLen(tt.maxLen); !x.NearEq(tt.want) {
+			t.Errorf("%s.ClampLen(%g) = %s, want %s", tt.v, tt.maxLen, x, tt.want)
+		}
+	}
+}
+
+func TestVec3MoveTowards(t *testing.T) {
+	tests := []struct {
+		v, target Vec3
+		maxDist   float32
+		want      Vec3
+	}{
+		{V3(1, 1, 1), V3(3, 4, 7), 3.5, V3(2, 2.5, 4)},
+		{V3(1, 1, 1), V3(3, 4, 7), 7, V3(3, 4, 7)},
+		{V3(1, 1, 1), V3(3, 4, 7), 8, V3(3, 4, 7)},
+		{V3(1, 1, 1), V3(3, 4, 7), -3.5, V3(0, -0.5, -2)},
+		{V3(1, 1, 1), V3(1, 1, 1), 1, V3(1, 1, 1)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.MoveTowards(tt.target, tt.maxDist); !x.NearEq(tt.want) {
+			t.Errorf("%s.MoveTowards(%s, %g) = %s, want %s", tt.v, tt.target, tt.maxDist, x, tt.want)
+		}
+	}
+}
+
+func TestVec3Refract(t *testing.T) {
+	s := float32(math.Sqrt2 / 2)
+	tests := []struct {
+		v, n   Vec3
+		eta    float32
+		want   Vec3
+		wantOK bool
+	}{
+		{V3(0, -s, s), V3(0, 1, 0), 1, V3(0, -s, s), true},
+		{V3(0, -1, 0), V3(0, 1, 0), 1.33, V3(0, -1, 0), true},
+		{V3(0, -s, s), V3(0, 1, 0), 1 / 1.5, V3(0, -0.8819171, 0.47140452), true},
+		{V3(0, -s, s), V3(0, 1, 0), 1.5, V3(0, 0, 0), false},
+	}
+	for _, tt := range tests {
+		x, ok := tt.v.Refract(tt.n, tt.eta)
+		if !x.NearEq(tt.want) || ok != tt.wantOK {
+			t.Errorf("%s.Refract(%s, %g) = %s, %v, want %s, %v", tt.v, tt.n, tt.eta, x, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestVec3Rotate(t *testing.T) {
+	tests := []struct {
+		v     Vec3
+		angle float32
+		axis  Vec3
+		want  Vec3
+	}{
+		{V3(1, 0, 0), math.Pi / 2, V3(0, 0, 1), V3(0, 1, 0)},
+		{V3(1, 0, 0), math.Pi / 2, V3(0, 0, -2), V3(0, -1, 0)},
+		{V3(0, 1, 0), math.Pi / 2, V3(1, 0, 0), V3(0, 0, 1)},
+		{V3(1, 0, 0), 2 * math.Pi / 3, V3(1, 1, 1), V3(0, 1, 0)},
+		{V3(2, 2, 2), 1.2, V3(1, 1, 1), V3(2, 2, 2)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Rotate(tt.angle, tt.axis); !x.NearEq(tt.want) {
+			t.Errorf("%s.Rotate(%g, %s) = %s, want %s", tt.v, tt.angle, tt.axis, x, tt.want)
+		}
+		var m Mat4
+		m.Rot(m.ID(), tt.angle, tt.axis)
+		if x, want := tt.v.Rotate(tt.angle, tt.axis), tt.v.TransformDir(&m); !x.NearEq(want) {
+			t.Errorf("%s.Rotate(%g, %s) = %s, Mat4.Rot gives %s", tt.v, tt.angle, tt.axis, x, want)
+		}
+	}
+}
+
+func TestVec3RotateTowards(t *testing.T) {
+	s := float32(math.Sqrt2)
+	tests := []struct {
+		v, target Vec3
+		maxAngle  float32
+		want      Vec3
+	}{
+		{V3(2, 0, 0), V3(0, 0, 1), math.Pi / 4, V3(s, 0, s)},
+		{V3(2, 0, 0), V3(0, 0, 1), math.Pi, V3(0, 0, 2)},
+		{V3(2, 0, 0), V3(0, 0, 0), 1, V3(2, 0, 0)},
+		{V3(2, 0, 0), V3(4, 0, 0), 1, V3(2, 0, 0)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.RotateTowards(tt.target, tt.maxAngle); !x.NearEq(tt.want) {
+			t.Errorf("%s.RotateTowards(%s, %g) = %s, want %s", tt.v, tt.target, tt.maxAngle, x, tt.want)
+		}
+	}
+	// Opposite directions: any perpendicular rotation axis is fine.
+	v, target := V3(1, 0, 0), V3(-1, 0, 0)
+	x := v.RotateTowards(target, math.Pi/2)
+	if !nearEq(x.Len(), 1, epsilon) || !nearEq(x.Dot(v), 0, epsilon) {
+		t.Errorf("%s.RotateTowards(%s, π/2) = %s, want unit vector perpendicular to %s", v, target, x, v)
+	}
+}
+
+func TestVec3Slerp(t *testing.T) {
+	s := float32(math.Sqrt2 / 2)
+	tests := []struct {
+		v, w Vec3
+		t    float32
+		want Vec3
+	}{
+		{V3(1, 0, 0), V3(0, 1, 0), 0, V3(1, 0, 0)},
+		{V3(1, 0, 0), V3(0, 1, 0), 1, V3(0, 1, 0)},
+		{V3(1, 0, 0), V3(0, 1, 0), 0.5, V3(s, s, 0)},
+		{V3(0, 0, 1), V3(0, 3, 0), 0.5, V3(0, 2*s, 2*s)},
+		{V3(1, 0, 0), V3(3, 0, 0), 0.5, V3(2, 0, 0)},
+		{V3(0, 0, 0), V3(0, 2, 0), 0.5, V3(0, 1, 0)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Slerp(tt.w, tt.t); !x.NearEq(tt.want) {
+			t.Errorf("%s.Slerp(%s, %g) = %s, want %s", tt.v, tt.w, tt.t, x, tt.want)
+		}
+	}
+	// Opposite directions
+	v, w := V3(0, 0, 2), V3(0, 0, -2)
+	x := v.Slerp(w, 0.5)
+	if !nearEq(x.Len(), 2, epsilon) || !nearEq(x.Dot(v), 0, epsilon) {
+		t.Errorf("%s.Slerp(%s, 0.5) = %s, want vector of length 2 perpendicular to %s", v, w, x, v)
+	}
+}
+
+func TestVec3OrthonormalBasis(t *testing.T) {
+	tests := []Vec3{
+		V3(0, 0, 1),
+		V3(0, 0, -1),
+		V3(1, 0, 0),
+		V3(0, -3, 0),
+		V3(1, 2, 3),
+		V3(-0.5, 0.1, -7),
+		V3(1, 1, 1e-7),
+	}
+	for _, v := range tests {
+		tv, bv := v.OrthonormalBasis()
+		n := v.Norm()
+		if !nearEq(tv.Len(), 1, epsilon) || !nearEq(bv.Len(), 1, epsilon) {
+			t.Errorf("%s.OrthonormalBasis() = %s, %s, want unit vectors", v, tv, bv)
+		}
+		if !nearEq(tv.Dot(n), 0, epsilon) || !nearEq(bv.Dot(n), 0, epsilon) || !nearEq(tv.Dot(bv), 0, epsilon) {
+			t.Errorf("%s.OrthonormalBasis() = %s, %s, want orthogonal vectors", v, tv, bv)
+		}
+		if x := tv.Cross(bv); !x.NearEq(n) {
+			t.Errorf("%s.OrthonormalBasis() = %s, %s, cross product %s, want %s", v, tv, bv, x, n)
+		}
+		if p := v.Perpendicular(); p != tv {
+			t.Errorf("%s.Perpendicular() = %s, want %s", v, p, tv)
+		}
+	}
+}
+
+func TestVec3NearEqTol(t *testing.T) {
+	v := V3(10000, 0.00001, -1)
+	w := V3(10000.001, 0.000011, -1)
+	if v.NearEq(w) {
+		t.Errorf("%s.NearEq(%s) = true, want false", v, w)
+	}
+	if !v.NearEqAbs(w, 0.01) {
+		t.Errorf("%s.NearEqAbs(%s, 0.01) = false, want true", v, w)
+	}
+	if v.NearEqAbs(w, 1e-6) {
+		t.Errorf("%s.NearEqAbs(%s, 1e-6) = true, want false", v, w)
+	}
+	if !v.NearEqRel(w, 0.1) {
+		t.Errorf("%s.NearEqRel(%s, 0.1) = false, want true", v, w)
+	}
+	if v.NearEqRel(w, 0.01) {
+		t.Errorf("%s.NearEqRel(%s, 0.01) = true, want false", v, w)
+	}
+	u := V3(math.Nextafter32(10000, 0), 0.00001, -1)
+	if !v.NearEqULP(u, 1) || v.NearEqULP(w, 1000) {
+		t.Errorf("NearEqULP with 1 ulp difference is false or with large difference is true")
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"math"
+	"strconv"
+)
+
+// A Vec3i represents a vector with integer coordinates X, Y and Z, e.g. the
+// index of a voxel or a cell in a 3-dimensional grid.
+type Vec3i struct {
+	X, Y, Z int
+}
+
+// V3i is shorthand for Vec3i{X: x, Y: y, Z: z}.
+func V3i(x, y, z int) Vec3i {
+	return Vec3i{x, y, z}
+}
+
+// Add returns the vector v+w.
+func (v Vec3i) Add(w Vec3i) Vec3i {
+	return Vec3i{v.X + w.X, v.Y + w.Y, v.Z + w.Z}
+}
+
+// Sub returns the vector v-w.
+func (v Vec3i) Sub(w Vec3i) Vec3i {
+	return Vec3i{v.X - w.X, v.Y - w.Y, v.Z - w.Z}
+}
+
+// Mul returns the vector v*s.
+func (v Vec3i) Mul(s int) Vec3i {
+	return Vec3i{v.X * s, v.Y * s, v.Z * s}
+}
+
+// Div returns the vector v/s. The components are truncated toward zero
+// like with Go's integer division.
+func (v Vec3i) Div(s int) Vec3i {
+	return Vec3i{v.X / s, v.Y / s, v.Z / s}
+}
+
+// Neg returns the negated vector of v.
+func (v Vec3i) Neg() Vec3i {
+	return Vec3i{-v.X, -v.Y, -v.Z}
+}
+
+// Dot returns the dot (a.k.a. scalar) product of v and w.
+func (v Vec3i) Dot(w Vec3i) int {
+	return v.X*w.X + v.Y*w.Y + v.Z*w.Z
+}
+
+// Cross returns the cross product of v and w.
+func (v Vec3i) Cross(w Vec3i) Vec3i {
+	return Vec3i{
+		v.Y*w.Z - v.Z*w.Y,
+		v.Z*w.X - v.X*w.Z,
+		v.X*w.Y - v.Y*w.X,
+	}
+}
+
+// CompMul returns the component-wise multiplication of two vectors.
+func (v Vec3i) CompMul(w Vec3i) Vec3i {
+	return Vec3i{v.X * w.X, v.Y * w.Y, v.Z * w.Z}
+}
+
+// Abs returns a vector with the absolute values of the components of v.
+func (v Vec3i) Abs() Vec3i {
+	return Vec3i{abs(v.X), abs(v.Y), abs(v.Z)}
+}
+
+// Min returns a vector with each component set to the lesser value
+// of the corresponding component pair of v and w.
+func (v Vec3i) Min(w Vec3i) Vec3i {
+	return Vec3i{min(v.X, w.X), min(v.Y, w.Y), min(v.Z, w.Z)}
+}
+
+// Max returns a vector with each component set to the greater value
+// of the corresponding component pair of v and w.
+func (v Vec3i) Max(w Vec3i) Vec3i {
+	return Vec3i{max(v.X, w.X), max(v.Y, w.Y), max(v.Z, w.Z)}
+}
+
+// ManhattanDist returns the Manhattan (taxicab) distance between two
+// vectors, i.e. the sum of the absolute differences of their components.
+// It is the number of steps between two grid cells if only the 6 direct
+// neighbors can be reached in one step.
+func (v Vec3i) ManhattanDist(w Vec3i) int {
+	d := v.Sub(w).Abs()
+	return d.X + d.Y + d.Z
+}
+
+// ChebyshevDist returns the Chebyshev (chessboard) distance between two
+// vectors, i.e. the greatest absolute difference of their components.
+// It is the number of steps between two grid cells if all 26 neighbors
+// can be reached in one step.
+func (v Vec3i) ChebyshevDist(w Vec3i) int {
+	d := v.Sub(w).Abs()
+	return max(d.X, d.Y, d.Z)
+}
+
+// Neighbors6 returns the 6 direct neighbors of v that share a face with
+// v, in the order +X, -X, +Y, -Y, +Z, -Z.
+func (v Vec3i) Neighbors6() [6]Vec3i {
+	return [...]Vec3i{
+		{v.X + 1, v.Y, v.Z},
+		{v.X - 1, v.Y, v.Z},
+		{v.X, v.Y + 1, v.Z},
+		{v.X, v.Y - 1, v.Z},
+		{v.X, v.Y, v.Z + 1},
+		{v.X, v.Y, v.Z - 1},
+	}
+}
+
+// Neighbors26 returns the 26 neighbors of v that share a face, an edge or
+// a corner with v, ordered by Z, then Y, then X from v+(-1,-1,-1) to
+// v+(1,1,1).
+func (v Vec3i) Neighbors26() [26]Vec3i {
+	var n [26]Vec3i
+	i := 0
+	for dz := -1; dz <= 1; dz++ {
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				if dx == 0 && dy == 0 && dz == 0 {
+					continue
+				}
+				n[i] = Vec3i{v.X + dx, v.Y + dy, v.Z + dz}
+				i++
+			}
+		}
+	}
+	return n
+}
+
+// XY returns the 2D vector with the X and Y coordinates of v.
+func (v Vec3i) XY() Vec2i {
+	return Vec2i{v.X, v.Y}
+}
+
+// Vec3 returns v converted to a Vec3 with float32 coordinates.
+func (v Vec3i) Vec3() Vec3 {
+	return Vec3{float32(v.X), float32(v.Y), float32(v.Z)}
+}
+
+// String returns a string representation of v like "(3, -4, 1)".
+func (v Vec3i) String() string {
+	return "(" + strconv.Itoa(v.X) + ", " + strconv.Itoa(v.Y) + ", " + strconv.Itoa(v.Z) + ")"
+}
+
+// Floor returns v converted to a Vec3i by rounding the coordinates
+// toward negative infinity.
+func (v Vector3[T]) Floor() Vec3i {
+	return Vec3i{
+		int(math.Floor(float64(v.X))),
+		int(math.Floor(float64(v.Y))),
+		int(math.Floor(float64(v.Z))),
+	}
+}
+
+// Round returns v converted to a Vec3i by rounding the coordinates to the
+// nearest integer, rounding half away from zero.
+func (v Vector3[T]) Round() Vec3i {
+	return Vec3i{
+		int(math.Round(float64(v.X))),
+		int(math.Round(float64(v.Y))),
+		int(math.Round(float64(v.Z))),
+	}
+}
+
+// Ceil returns v converted to a Vec3i by rounding the coordinates
+// toward positive infinity.
+func (v Vector3[T]) Ceil() Vec3i {
+	return Vec3i{
+		int(math.Ceil(float64(v.X))),
+		int(math.Ceil(float64(v.Y))),
+		int(math.Ceil(float64(v.Z))),
+	}
+}
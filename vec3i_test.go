@@ -0,0 +1,98 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import "testing"
+
+func TestVec3iArithmetic(t *testing.T) {
+	v, w := V3i(3, -4, 1), V3i(-1, 2, 5)
+	tests := []struct {
+		name string
+		x    Vec3i
+		want Vec3i
+	}{
+		{"Add", v.Add(w), V3i(2, -2, 6)},
+		{"Sub", v.Sub(w), V3i(4, -6, -4)},
+		{"Mul", v.Mul(3), V3i(9, -12, 3)},
+		{"Div", v.Div(2), V3i(1, -2, 0)},
+		{"Neg", v.Neg(), V3i(-3, 4, -1)},
+		{"Cross", v.Cross(w), V3i(-22, -16, 2)},
+		{"CompMul", v.CompMul(w), V3i(-3, -8, 5)},
+		{"Abs", v.Abs(), V3i(3, 4, 1)},
+		{"Min", v.Min(w), V3i(-1, -4, 1)},
+		{"Max", v.Max(w), V3i(3, 2, 5)},
+	}
+	for _, tt := range tests {
+		if tt.x != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, tt.x, tt.want)
+		}
+	}
+	if x := v.Dot(w); x != -6 {
+		t.Errorf("%s.Dot(%s) = %d, want -6", v, w, x)
+	}
+}
+
+func TestVec3iDist(t *testing.T) {
+	tests := []struct {
+		v, w      Vec3i
+		manhattan int
+		chebyshev int
+	}{
+		{V3i(0, 0, 0), V3i(0, 0, 0), 0, 0},
+		{V3i(1, 2, 3), V3i(4, 6, -5), 15, 8},
+		{V3i(-3, 5, 0), V3i(2, 4, 1), 7, 5},
+	}
+	for _, tt := range tests {
+		if x := tt.v.ManhattanDist(tt.w); x != tt.manhattan {
+			t.Errorf("%s.ManhattanDist(%s) = %d, want %d", tt.v, tt.w, x, tt.manhattan)
+		}
+		if x := tt.v.ChebyshevDist(tt.w); x != tt.chebyshev {
+			t.Errorf("%s.ChebyshevDist(%s) = %d, want %d", tt.v, tt.w, x, tt.chebyshev)
+		}
+	}
+}
+
+func TestVec3iNeighbors(t *testing.T) {
+	v := V3i(1, 2, 3)
+	want6 := [...]Vec3i{{2, 2, 3}, {0, 2, 3}, {1, 3, 3}, {1, 1, 3}, {1, 2, 4}, {1, 2, 2}}
+	if x := v.Neighbors6(); x != want6 {
+		t.Errorf("%s.Neighbors6() = %v, want %v", v, x, want6)
+	}
+	n26 := v.Neighbors26()
+	if n26[0] != V3i(0, 1, 2) || n26[25] != V3i(2, 3, 4) {
+		t.Errorf("%s.Neighbors26() = %v, want (0, 1, 2) first and (2, 3, 4) last", v, n26)
+	}
+	for _, n := range n26 {
+		if d := v.ChebyshevDist(n); d != 1 {
+			t.Errorf("neighbor %s of %s has Chebyshev distance %d, want 1", n, v, d)
+		}
+	}
+}
+
+func TestVec3Floor(t *testing.T) {
+	v := V3(1.5, -0.5, 2)
+	if x := v.Floor(); x != V3i(1, -1, 2) {
+		t.Errorf("%s.Floor() = %s, want (1, -1, 2)", v, x)
+	}
+	if x := v.Round(); x != V3i(2, -1, 2) {
+		t.Errorf("%s.Round() = %s, want (2, -1, 2)", v, x)
+	}
+	if x := v.Ceil(); x != V3i(2, 0, 2) {
+		t.Errorf("%s.Ceil() = %s, want (2, 0, 2)", v, x)
+	}
+}
+
+func TestVec3iConversions(t *testing.T) {
+	v := V3i(7, -3, 4)
+	if x := v.Vec3(); x != V3(7, -3, 4) {
+		t.Errorf("%s.Vec3() = %s, want (7, -3, 4)", v, x)
+	}
+	if x := v.XY(); x != V2i(7, -3) {
+		t.Errorf("%s.XY() = %s, want (7, -3)", v, x)
+	}
+	if s := v.String(); s != "(7, -3, 4)" {
+		t.Errorf("%#v.String() = %q, want %q", v, s, "(7, -3, 4)")
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import "math"
+
+// A Vector4 represents a vector with coordinates X, Y, Z and W in
+// 4-dimensional space. It is typically used for homogeneous coordinates of
+// points and directions in 3-dimensional space.
+type Vector4[T Float] struct {
+	X, Y, Z, W T
+}
+
+// A Vec4 is a 4-dimensional vector with float32 coordinates.
+type Vec4 = Vector4[float32]
+
+// A Vec4d is a 4-dimensional vector with float64 coordinates.
+type Vec4d = Vector4[float64]
+
+var (
+	// V4Zero is the zero vector (0,0,0,0).
+	V4Zero = Vec4{0, 0, 0, 0}
+	// V4Unit is the unit vector (1,1,1,1).
+	V4Unit = Vec4{1, 1, 1, 1}
+	// V4UnitX is the x-axis unit vector (1,0,0,0).
+	V4UnitX = Vec4{1, 0, 0, 0}
+	// V4UnitY is the y-axis unit vector (0,1,0,0).
+	V4UnitY = Vec4{0, 1, 0, 0}
+	// V4UnitZ is the z-axis unit vector (0,0,1,0).
+	V4UnitZ = Vec4{0, 0, 1, 0}
+	// V4UnitW is the w-axis unit vector (0,0,0,1).
+	V4UnitW = Vec4{0, 0, 0, 1}
+)
+
+// V4 is shorthand for Vec4{X: x, Y: y, Z: z, W: w}.
+func V4(x, y, z, w float32) Vec4 {
+	return Vec4{x, y, z, w}
+}
+
+// V4d is shorthand for Vec4d{X: x, Y: y, Z: z, W: w}.
+func V4d(x, y, z, w float64) Vec4d {
+	return Vec4d{x, y, z, w}
+}
+
+// Add returns the vector v+w.
+func (v Vector4[T]) Add(w Vector4[T]) Vector4[T] {
+	return Vector4[T]{v.X + w.X, v.Y + w.Y, v.Z + w.Z, v.W + w.W}
+}
+
+// Sub returns the vector v-w.
+func (v Vector4[T]) Sub(w Vector4[T]) Vector4[T] {
+	return Vector4[T]{v.X - w.X, v.Y - w.Y, v.Z - w.Z, v.W - w.W}
+}
+
+// Mul returns the vector v*s.
+func (v Vector4[T]) Mul(s T) Vector4[T] {
+	return Vector4[T]{v.X * s, v.Y * s, v.Z * s, v.W * s}
+}
+
+// Div returns the vector v/s.
+func (v Vector4[T]) Div(s T) Vector4[T] {
+	return Vector4[T]{v.X / s, v.Y / s, v.Z / s, v.W / s}
+}
+
+// Neg returns the negated vector of v.
+func (v Vector4[T]) Neg() Vector4[T] {
+	return v.Mul(-1)
+}
+
+// Dot returns the dot (a.k.a. scalar) product of v and w.
+func (v Vector4[T]) Dot(w Vector4[T]) T {
+	return v.X*w.X + v.Y*w.Y + v.Z*w.Z + v.W*w.W
+}
+
+// CompMul returns the component-wise multiplication of two vectors.
+func (v Vector4[T]) CompMul(w Vector4[T]) Vector4[T] {
+	return Vector4[T]{v.X * w.X, v.Y * w.Y, v.Z * w.Z, v.W * w.W}
+}
+
+// CompDiv returns the component-wise division of two vectors.
+func (v Vector4[T]) CompDiv(w Vector4[T]) Vector4[T] {
+	return Vector4[T]{v.X / w.X, v.Y / w.Y, v.Z / w.Z, v.W / w.W}
+}
+
+// SqDist returns the square of the euclidean distance between two vectors.
+func (v Vector4[T]) SqDist(w Vector4[T]) T {
+	return v.Sub(w).SqLen()
+}
+
+// Dist returns the euclidean distance between two vectors.
+func (v Vector4[T]) Dist(w Vector4[T]) T {
+	return v.Sub(w).Len()
+}
+
+// SqLen returns the square of the length (euclidean norm) of a vector.
+func (v Vector4[T]) SqLen() T {
+	return v.Dot(v)
+}
+
+// Len returns the length (euclidean norm) of a vector.
+func (v Vector4[T]) Len() T {
+	return T(math.Sqrt(float64(v.SqLen())))
+}
+
+// Norm returns the normalized vector of a vector.
+func (v Vector4[T]) Norm() Vector4[T] {
+	l := v.Len()
+	return Vector4[T]{v.X / l, v.Y / l, v.Z / l, v.W / l}
+}
+
+// Reflect returns the reflection vector of v given a normal n.
+func (v Vector4[T]) Reflect(n Vector4[T]) Vector4[T] {
+	return v.Sub(n.Mul(2 * v.Dot(n)))
+}
+
+// Lerp returns the linear interpolation between v and w by amount t.
+// The amount t is usually a value between 0 and 1. If t=0 v will be
+// returned; if t=1 w will be returned.
+func (v Vector4[T]) Lerp(w Vector4[T], t T) Vector4[T] {
+	return Vector4[T]{
+		v.X + (w.X-v.X)*t,
+		v.Y + (w.Y-v.Y)*t,
+		v.Z + (w.Z-v.Z)*t,
+		v.W + (w.W-v.W)*t,
+	}
+}
+
+// Min returns a vector with each component set to the lesser value
+// of the corresponding component pair of v and w.
+func (v Vector4[T]) Min(w Vector4[T]) Vector4[T] {
+	return Vector4[T]{
+		min(v.X, w.X),
+		min(v.Y, w.Y),
+		min(v.Z, w.Z),
+		min(v.W, w.W),
+	}
+}
+
+// Max returns a vector with each component set to the greater value
+// of the corresponding component pair of v and w.
+func (v Vector4[T]) Max(w Vector4[T]) Vector4[T] {
+	return Vector4[T]{
+		max(v.X, w.X),
+		max(v.Y, w.Y),
+		max(v.Z, w.Z),
+		max(v.W, w.W),
+	}
+}
+
+// Transform transforms vector v with 4x4 matrix m.
+func (v Vector4[T]) Transform(m *Matrix4[T]) Vector4[T] {
+	return Vector4[T]{
+		m[0][0]*v.X + m[1][0]*v.Y + m[2][0]*v.Z + m[3][0]*v.W,
+		m[0][1]*v.X + m[1][1]*v.Y + m[2][1]*v.Z + m[3][1]*v.W,
+		m[0][2]*v.X + m[1][2]*v.Y + m[2][2]*v.Z + m[3][2]*v.W,
+		m[0][3]*v.X + m[1][3]*v.Y + m[2][3]*v.Z + m[3][3]*v.W,
+	}
+}
+
+// XYZ returns the Vec3 of the X, Y and Z coordinates of v, dropping W.
+func (v Vector4[T]) XYZ() Vector3[T] {
+	return Vector3[T]{v.X, v.Y, v.Z}
+}
+
+// PerspDiv returns the Vec3 of the X, Y and Z coordinates of v divided
+// by W (perspective division).
+func (v Vector4[T]) PerspDiv() Vector3[T] {
+	return Vector3[T]{v.X / v.W, v.Y / v.W, v.Z / v.W}
+}
+
+// NearEq returns whether v and w are approximately equal. This relation is not
+// transitive in general. The tolerance for the floating-point components is
+// ±1e-5.
+func (v Vector4[T]) NearEq(w Vector4[T]) bool {
+	return nearEq(v.X, w.X, epsilon) &&
+		nearEq(v.Y, w.Y, epsilon) &&
+		nearEq(v.Z, w.Z, epsilon) &&
+		nearEq(v.W, w.W, epsilon)
+}
+
+// String returns a string representation of v like "(3.25, -1.5, 1.2, 1)".
+func (v Vector4[T]) String() string {
+	return "(" + str(v.X) + ", " + str(v.Y) + ", " + str(v.Z) + ", " + str(v.W) + ")"
+}
+
+// Float32 returns v with its coordinates converted to float32.
+func (v Vector4[T]) Float32() Vec4 {
+	return Vec4{float32(v.X), float32(v.Y), float32(v.Z), float32(v.W)}
+}
+
+// Float64 returns v with its coordinates converted to float64.
+func (v Vector4[T]) Float64() Vec4d {
+	return Vec4d{float64(v.X), float64(v.Y), float64(v.Z), float64(v.W)}
+}
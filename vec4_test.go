@@ -0,0 +1,250 @@
+// Copyright 2026 Frederik Zipp. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package geom
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVec4String(t *testing.T) {
+	tests := []struct {
+		v    Vec4
+		want string
+	}{
+		{V4(-2.3, 1.1, 12.72, 1), "(-2.3, 1.1, 12.72, 1)"},
+		{V4(2, 1, 4, 0), "(2, 1, 4, 0)"},
+		{V4(1.414213, -34.0213, 651.0284, -0.5), "(1.414213, -34.0213, 651.0284, -0.5)"},
+	}
+	for _, tt := range tests {
+		if s := tt.v.String(); s != tt.want {
+			t.Errorf("(%g, %g, %g, %g).String() = %q, want %q", tt.v.X, tt.v.Y, tt.v.Z, tt.v.W, s, tt.want)
+		}
+	}
+}
+
+func TestVec4NearEq(t *testing.T) {
+	tests := []struct {
+		v, w Vec4
+		want bool
+	}{
+		{V4(4, 1, 8, 2), V4(4, 1, 8, 2), true},
+		{V4(2.34567, -9.87654, 7.97433, 1.23456), V4(2.345669, -9.876541, 7.974329, 1.234561), true},
+		{V4(4, 1, 6, 1), V4(4, 1, 6, 0), false},
+		{V4(2.34567, -9.87654, 5.43553, 1), V4(2.34567, -9.87654, 5.43554, 1), false},
+		{V4(2.34567, -9.87654, 5.43553, 1), V4(2.34567, -9.87654, 5.43553, 1.00001), false},
+	}
+	for _, tt := range tests {
+		if x := tt.v.NearEq(tt.w); x != tt.want {
+			t.Errorf("%s.NearEq(%s) = %v, want %v", tt.v, tt.w, x, tt.want)
+		}
+	}
+}
+
+func TestVec4AddSub(t *testing.T) {
+	tests := []struct {
+		v, w, sum, diff Vec4
+	}{
+		{V4(4, 1, 8, 1), V4(2, 5, 3, 0), V4(6, 6, 11, 1), V4(2, -4, 5, 1)},
+		{V4(1.2, 2.3, -2.7, 0.5), V4(-2.1, 0.5, -1.3, 0.5), V4(-0.9, 2.8, -4, 1), V4(3.3, 1.8, -1.4, 0)},
+		{V4(12.5, 9.25, 44.2, 3), V4Zero, V4(12.5, 9.25, 44.2, 3), V4(12.5, 9.25, 44.2, 3)},
+		{V4UnitX, V4UnitW, V4(1, 0, 0, 1), V4(1, 0, 0, -1)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Add(tt.w); !x.NearEq(tt.sum) {
+			t.Errorf("%s + %s = %s, want %s", tt.v, tt.w, x, tt.sum)
+		}
+		if x := tt.v.Sub(tt.w); !x.NearEq(tt.diff) {
+			t.Errorf("%s - %s = %s, want %s", tt.v, tt.w, x, tt.diff)
+		}
+	}
+}
+
+func TestVec4MulDivNeg(t *testing.T) {
+	tests := []struct {
+		v               Vec4
+		s               float32
+		prod, quot, neg Vec4
+	}{
+		{V4(4, 1, 8, 2), 2, V4(8, 2, 16, 4), V4(2, 0.5, 4, 1), V4(-4, -1, -8, -2)},
+		{V4(1.4, -2.5, 3, -1), 0.5, V4(0.7, -1.25, 1.5, -0.5), V4(2.8, -5, 6, -2), V4(-1.4, 2.5, -3, 1)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Mul(tt.s); !x.NearEq(tt.prod) {
+			t.Errorf("%g * %s = %s, want %s", tt.s, tt.v, x, tt.prod)
+		}
+		if x := tt.v.Div(tt.s); !x.NearEq(tt.quot) {
+			t.Errorf("%s / %g = %s, want %s", tt.v, tt.s, x, tt.quot)
+		}
+		if x := tt.v.Neg(); !x.NearEq(tt.neg) {
+			t.Errorf("%s.Neg() = %s, want %s", tt.v, x, tt.neg)
+		}
+	}
+}
+
+func TestVec4Dot(t *testing.T) {
+	tests := []struct {
+		v, w Vec4
+		want float32
+	}{
+		{V4(2, -3, 1, 2), V4(-4, 2, 3, 0.5), -10},
+		{V4(12.5, 9.25, 3, 1), V4Zero, 0},
+		{V4UnitX, V4UnitW, 0},
+		{V4(4, 5, 6, 7), V4Unit, 22},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Dot(tt.w); x != tt.want {
+			t.Errorf("%s.Dot(%s) = %g, want %g", tt.v, tt.w, x, tt.want)
+		}
+	}
+}
+
+func TestVec4CompMulDiv(t *testing.T) {
+	tests := []struct {
+		v, w, prod, quot Vec4
+	}{
+		{V4(4, 1, 6, 2), V4(2, 5, 3, 4), V4(8, 5, 18, 8), V4(2, 0.2, 2, 0.5)},
+		{V4(2, 3, 4, 5), V4Unit, V4(2, 3, 4, 5), V4(2, 3, 4, 5)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.CompMul(tt.w); !x.NearEq(tt.prod) {
+			t.Errorf("%s.CompMul(%s) = %s, want %s", tt.v, tt.w, x, tt.prod)
+		}
+		if x := tt.v.CompDiv(tt.w); !x.NearEq(tt.quot) {
+			t.Errorf("%s.CompDiv(%s) = %s, want %s", tt.v, tt.w, x, tt.quot)
+		}
+	}
+}
+
+func TestVec4LenDist(t *testing.T) {
+	tests := []struct {
+		v, w        Vec4
+		len, sqDist float32
+	}{
+		{V4Zero, V4Zero, 0, 0},
+		{V4Unit, V4Zero, 2, 4},
+		{V4(1, 2, 2, 4), V4(1, 2, 2, 0), 5, 16},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Len(); !nearEq(x, tt.len, epsilon) {
+			t.Errorf("%s.Len() = %g, want %g", tt.v, x, tt.len)
+		}
+		if x := tt.v.SqLen(); !nearEq(x, tt.len*tt.len, epsilon) {
+			t.Errorf("%s.SqLen() = %g, want %g", tt.v, x, tt.len*tt.len)
+		}
+		if x := tt.v.SqDist(tt.w); !nearEq(x, tt.sqDist, epsilon) {
+			t.Errorf("%s.SqDist(%s) = %g, want %g", tt.v, tt.w, x, tt.sqDist)
+		}
+		if x, d := tt.v.Dist(tt.w), float32(math.Sqrt(float64(tt.sqDist))); !nearEq(x, d, epsilon) {
+			t.Errorf("%s.Dist(%s) = %g, want %g", tt.v, tt.w, x, d)
+		}
+	}
+}
+
+func TestVec4Norm(t *testing.T) {
+	tests := []struct {
+		v, want Vec4
+	}{
+		{V4UnitX, V4UnitX},
+		{V4UnitW, V4UnitW},
+		{V4Unit, V4(0.5, 0.5, 0.5, 0.5)},
+		{V4(1, 2, 2, 4), V4(0.2, 0.4, 0.4, 0.8)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Norm(); !x.NearEq(tt.want) {
+			t.Errorf("%s.Norm() = %s, want %s", tt.v, x, tt.want)
+		}
+	}
+}
+
+func TestVec4Reflect(t *testing.T) {
+	tests := []struct {
+		v, n, want Vec4
+	}{
+		{V4Unit, V4UnitY, V4(1, -1, 1, 1)},
+		{V4(2, 3, 4, 5), V4UnitW, V4(2, 3, 4, -5)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Reflect(tt.n); !x.NearEq(tt.want) {
+			t.Errorf("%s.Reflect(%s) = %s, want %s", tt.v, tt.n, x, tt.want)
+		}
+	}
+}
+
+func TestVec4Lerp(t *testing.T) {
+	tests := []struct {
+		v, w Vec4
+		t    float32
+		want Vec4
+	}{
+		{V4Zero, V4UnitW, 0.0, V4Zero},
+		{V4Zero, V4UnitW, 0.25, V4(0, 0, 0, 0.25)},
+		{V4Zero, V4UnitW, 1.0, V4UnitW},
+		{V4(2, 1, 0, 1), V4(4, 3, 2, 1), 0.5, V4(3, 2, 1, 1)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Lerp(tt.w, tt.t); !x.NearEq(tt.want) {
+			t.Errorf("%s.Lerp(%s, %g) = %s, want %s", tt.v, tt.w, tt.t, x, tt.want)
+		}
+	}
+}
+
+func TestVec4MinMax(t *testing.T) {
+	tests := []struct {
+		v, w, min, max Vec4
+	}{
+		{V4(2, 1, 4, 0), V4(4, 3, 2, 1), V4(2, 1, 2, 0), V4(4, 3, 4, 1)},
+		{V4(5, 3.2, -1, 8), V4(3.2, 1.4, 2, -8), V4(3.2, 1.4, -1, -8), V4(5, 3.2, 2, 8)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Min(tt.w); !x.NearEq(tt.min) {
+			t.Errorf("%s.Min(%s) = %s, want %s", tt.v, tt.w, x, tt.min)
+		}
+		if x := tt.v.Max(tt.w); !x.NearEq(tt.max) {
+			t.Errorf("%s.Max(%s) = %s, want %s", tt.v, tt.w, x, tt.max)
+		}
+	}
+}
+
+func TestVec4Transform(t *testing.T) {
+	var rot, trans, persp Mat4
+	rot.ID().Rot(&rot, math.Pi/2, V3UnitZ)
+	trans.ID().Translate(&trans, V3(2.5, 3, -1))
+	persp.Perspective(math.Pi/2, 1, 1, 3)
+
+	tests := []struct {
+		v    Vec4
+		m    *Mat4
+		want Vec4
+	}{
+		{V4(1, 0, 2, 1), &rot, V4(0, 1, 2, 1)},
+		{V4(1, 2, 3, 1), &trans, V4(3.5, 5, 2, 1)},
+		{V4(1, 2, 3, 0), &trans, V4(1, 2, 3, 0)},
+		{V4(1, 2, -2, 1), &persp, V4(1, 2, 1, 2)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.Transform(tt.m); !x.NearEq(tt.want) {
+			t.Errorf("%s.Transform(%v) = %s, want %s", tt.v, *tt.m, x, tt.want)
+		}
+	}
+}
+
+func TestVec4XYZ(t *testing.T) {
+	tests := []struct {
+		v            Vec4
+		xyz, divided Vec3
+	}{
+		{V4(1, 2, 3, 1), V3(1, 2, 3), V3(1, 2, 3)},
+		{V4(2, -4, 6, 2), V3(2, -4, 6), V3(1, -2, 3)},
+	}
+	for _, tt := range tests {
+		if x := tt.v.XYZ(); x != tt.xyz {
+			t.Errorf("%s.XYZ() = %s, want %s", tt.v, x, tt.xyz)
+		}
+		if x := tt.v.PerspDiv(); !x.NearEq(tt.divided) {
+			t.Errorf("%s.PerspDiv() = %s, want %s", tt.v, x, tt.divided)
+		}
+	}
+}